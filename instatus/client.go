@@ -3,8 +3,10 @@ package instatus
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"time"
 )
@@ -20,9 +22,51 @@ const (
 // Client handles communication with the Instatus API
 type Client struct {
 	apiKey     string
+	baseURL    string
 	httpClient *http.Client
 }
 
+// APIError is returned when the Instatus API responds with a non-2xx status
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("API request failed with status %d: %s", e.StatusCode, e.Body)
+}
+
+// IsNotFound reports whether err is an API error for an object that does not exist
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// listPaginated follows the page/per_page pagination of list endpoints
+// until a short page is returned
+func listPaginated[T any](c *Client, endpoint string) ([]T, error) {
+	const perPage = 100
+
+	var items []T
+	for pageNumber := 1; ; pageNumber++ {
+		respBody, err := c.doRequest("GET", fmt.Sprintf("%s?page=%d&per_page=%d", endpoint, pageNumber, perPage), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp []T
+		if err := json.Unmarshal(respBody, &resp); err != nil {
+			return nil, fmt.Errorf("error unmarshaling response: %w", err)
+		}
+
+		items = append(items, resp...)
+
+		if len(resp) < perPage {
+			return items, nil
+		}
+	}
+}
+
 // Components
 // Component represents an Instatus component
 type Component struct {
@@ -60,7 +104,8 @@ type ComponentResponse struct {
 // NewClient creates a new Instatus API client
 func NewClient(apiKey string) *Client {
 	return &Client{
-		apiKey: apiKey,
+		apiKey:  apiKey,
+		baseURL: baseURL,
 		httpClient: &http.Client{
 			Timeout: time.Second * 30,
 		},
@@ -98,7 +143,7 @@ func (c *Client) InDevdoRequest(method, endpoint string, body interface{}) ([]by
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	return respBody, nil
@@ -115,7 +160,7 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, e
 		reqBody = bytes.NewBuffer(jsonBody)
 	}
 
-	url := fmt.Sprintf("%s%s", baseURL, endpoint)
+	url := fmt.Sprintf("%s%s", c.baseURL, endpoint)
 	req, err := http.NewRequest(method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("error creating request: %w", err)
@@ -136,7 +181,7 @@ func (c *Client) doRequest(method, endpoint string, body interface{}) ([]byte, e
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(respBody))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: string(respBody)}
 	}
 
 	return respBody, nil
@@ -189,6 +234,7 @@ func (c *Client) GetComponent(componentID string, pageID string) (*Component, er
 	// Convert response to Component
 	component := &Component{
 		ID:          resp.ID,
+		PageId:      pageID,
 		Name:        resp.Name,
 		Description: resp.Description,
 		Status:      resp.Status,
@@ -242,6 +288,32 @@ func (c *Client) UpdateComponent(componentID string, component *Component) (*Com
 	return updated, nil
 }
 
+// ListComponents retrieves every component of a status page
+func (c *Client) ListComponents(pageID string) ([]Component, error) {
+	resp, err := listPaginated[ComponentResponse](c, fmt.Sprintf("/v2/%s/components", pageID))
+	if err != nil {
+		return nil, err
+	}
+
+	components := make([]Component, 0, len(resp))
+	for _, item := range resp {
+		components = append(components, Component{
+			ID:          item.ID,
+			Name:        item.Name,
+			Description: item.Description,
+			Status:      item.Status,
+			ShowUptime:  item.ShowUptime,
+			Order:       item.Order,
+			GroupIDRead: item.GroupID,
+			Archived:    item.Archived,
+			UniqueEmail: item.UniqueEmail,
+			PageId:      pageID,
+		})
+	}
+
+	return components, nil
+}
+
 // DeleteComponent deletes a component
 func (c *Client) DeleteComponent(componentID string, pageID string) error {
 	endpoint := fmt.Sprintf("/v1/%s/components/%s", pageID, componentID)
@@ -253,17 +325,105 @@ func (c *Client) DeleteComponent(componentID string, pageID string) error {
 // Status Page
 // Status Page represents an Instatus status page
 type Page struct {
-	ID               string      `json:"id"`
-	WorkspaceID      string      `json:"workspaceId"`
-	Email            string      `json:"email"`
-	Name             string      `json:"name"`
-	WorkspaceSlug    string      `json:"workspaceSlug"`
-	Subdomain        string      `json:"subdomain"`
-	Components       []Component `json:"components"`
-	LogoURL          string      `json:"logoUrl,omitempty"`
-	FaviconURL       string      `json:"faviconUrl,omitempty"`
-	GoogleAnalytics  string      `json:"googleAnalytics,omitempty"`
-	CustomDomain     string      `json:"customDomain,omitempty"`
+	ID               string            `json:"id"`
+	WorkspaceID      string            `json:"workspaceId,omitempty"`
+	Email            string            `json:"email"`
+	Name             string            `json:"name"`
+	WorkspaceSlug    string            `json:"workspaceSlug"`
+	Subdomain        string            `json:"subdomain"`
+	Components       []Component       `json:"components"`
+	LogoURL          string            `json:"logoUrl,omitempty"`
+	FaviconURL       string            `json:"faviconUrl,omitempty"`
+	GoogleAnalytics  string            `json:"googleAnalytics,omitempty"`
+	CustomDomain     string            `json:"customDomain,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	DefaultLanguage  string            `json:"language,omitempty"`
+	Languages        []string          `json:"languages,omitempty"`
+	Translations     *PageTranslations `json:"translations,omitempty"`
+	*PageTheme
+	*PageAccess
+}
+
+// PageTranslations holds the localized name and description of a status
+// page, keyed by language code
+type PageTranslations struct {
+	Name        map[string]string `json:"name,omitempty"`
+	Description map[string]string `json:"description,omitempty"`
+}
+
+// LocalizedText is a text the API returns either as a plain string or as an
+// object holding a "default" value and one value per language
+type LocalizedText struct {
+	Default      string
+	Translations map[string]string
+}
+
+// UnmarshalJSON accepts both the plain string and the localized object forms
+func (t *LocalizedText) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		t.Default = text
+		t.Translations = nil
+		return nil
+	}
+
+	var localized map[string]string
+	if err := json.Unmarshal(data, &localized); err != nil {
+		return err
+	}
+
+	t.Default = localized["default"]
+	delete(localized, "default")
+	t.Translations = localized
+
+	return nil
+}
+
+// PageTheme holds the appearance settings of a status page. It is embedded
+// in the page structs so the fields are flattened into the request body, and
+// left nil when no theme is managed so that nothing is sent. Colours, mode
+// and uptime days are omitted when empty so Instatus keeps its defaults,
+// while custom HTML/CSS is always sent so it can be cleared. The page
+// language lives on the page structs as DefaultLanguage.
+type PageTheme struct {
+	BrandColor        string `json:"brandColor,omitempty"`
+	OkColor           string `json:"okColor,omitempty"`
+	DisruptedColor    string `json:"disruptedColor,omitempty"`
+	DegradedColor     string `json:"degradedColor,omitempty"`
+	DownColor         string `json:"downColor,omitempty"`
+	NoticeColor       string `json:"noticeColor,omitempty"`
+	UnknownColor      string `json:"unknownColor,omitempty"`
+	Theme             string `json:"theme,omitempty"`
+	CSSGlobal         string `json:"cssGlobal"`
+	HTMLAboveHeader   string `json:"htmlAboveHeader"`
+	HTMLBelowHeader   string `json:"htmlBelowHeader"`
+	HTMLAboveFooter   string `json:"htmlAboveFooter"`
+	HTMLBelowFooter   string `json:"htmlBelowFooter"`
+	UseLargeHeader    bool   `json:"useLargeHeader"`
+	UptimeDaysDisplay int    `json:"uptimeDaysDisplay,omitempty"`
+}
+
+// PageAccess holds the access control settings of a status page. Like
+// PageTheme it is embedded in the page structs and left nil when unmanaged.
+// Instatus never returns the password or the SSO client secret.
+type PageAccess struct {
+	Private     bool     `json:"private"`
+	Password    string   `json:"password,omitempty"`
+	IPAllowlist []string `json:"ipAllowlist"`
+	SSO         *PageSSO `json:"sso"`
+}
+
+// PageSSO holds the SAML or OIDC single sign-on settings of a private page
+type PageSSO struct {
+	Protocol       string   `json:"protocol"`
+	MetadataURL    string   `json:"metadataUrl,omitempty"`
+	EntityID       string   `json:"entityId,omitempty"`
+	SSOURL         string   `json:"ssoUrl,omitempty"`
+	Certificate    string   `json:"certificate,omitempty"`
+	IssuerURL      string   `json:"issuerUrl,omitempty"`
+	ClientID       string   `json:"clientId,omitempty"`
+	ClientSecret   string   `json:"clientSecret,omitempty"`
+	AllowedDomains []string `json:"allowedDomains,omitempty"`
 }
 
 // Only 3 fields in the create response
@@ -273,41 +433,55 @@ type PageCreateResponse struct {
 	WorkspaceSlug string `json:"workspaceSlug"`
 }
 
-// Only 4 fields in the get response
+// Name and description may be localized in the get response
 type PageGetResponse struct {
-	ID               string `json:"id"`
-	WorkspaceID      string `json:"workspaceId"`
-	WorkspaceSlug    string `json:"subdomain"`
-	Name             string `json:"name"`
-	LogoURL          string `json:"logoUrl,omitempty"`
-	FaviconURL       string `json:"faviconUrl,omitempty"`
-	GoogleAnalytics  string `json:"googleAnalytics,omitempty"`
-	CustomDomain     string `json:"customDomain,omitempty"`
+	ID               string        `json:"id"`
+	WorkspaceID      string        `json:"workspaceId"`
+	WorkspaceSlug    string        `json:"subdomain"`
+	Name             LocalizedText `json:"name"`
+	Description      LocalizedText `json:"description"`
+	LogoURL          string        `json:"logoUrl,omitempty"`
+	FaviconURL       string        `json:"faviconUrl,omitempty"`
+	GoogleAnalytics  string        `json:"googleAnalytics,omitempty"`
+	CustomDomain     string        `json:"customDomain,omitempty"`
+	DefaultLanguage  string        `json:"language,omitempty"`
+	Languages        []string      `json:"languages,omitempty"`
+	*PageTheme
+	*PageAccess
 }
 
+// Branding fields are sent even when empty so that removing them from the
+// configuration clears them on the page
 type PageUpdate struct {
-	Email            string      `json:"email"`
-	Name             string      `json:"name"`
-	WorkspaceSlug    string      `json:"subdomain"`
-	Components       []Component `json:"components"`
-	LogoURL          string      `json:"logoUrl,omitempty"`
-	FaviconURL       string      `json:"faviconUrl,omitempty"`
-	GoogleAnalytics  string      `json:"googleAnalytics,omitempty"`
-	CustomDomain     string      `json:"customDomain,omitempty"`
+	Email            string            `json:"email"`
+	Name             string            `json:"name"`
+	WorkspaceSlug    string            `json:"subdomain"`
+	Components       []Component       `json:"components,omitempty"`
+	LogoURL          string            `json:"logoUrl"`
+	FaviconURL       string            `json:"faviconUrl"`
+	GoogleAnalytics  string            `json:"googleAnalytics"`
+	CustomDomain     string            `json:"customDomain"`
+	Description      string            `json:"description"`
+	DefaultLanguage  string            `json:"language,omitempty"`
+	Languages        []string          `json:"languages,omitempty"`
+	Translations     *PageTranslations `json:"translations,omitempty"`
+	*PageTheme
+	*PageAccess
 }
 
-type PageUpdateResponseName struct {
-	En      string `json:"en"`
-	Default string `json:"default"`
-}
 type PageUpdateResponse struct {
-	ID               string                 `json:"id"`
-	WorkspaceSlug    string                 `json:"subdomain"`
-	Name             PageUpdateResponseName `json:"name"`
-	LogoURL          string                 `json:"logoUrl,omitempty"`
-	FaviconURL       string                 `json:"faviconUrl,omitempty"`
-	GoogleAnalytics  string                 `json:"googleAnalytics,omitempty"`
-	CustomDomain     string                 `json:"customDomain,omitempty"`
+	ID               string        `json:"id"`
+	WorkspaceSlug    string        `json:"subdomain"`
+	Name             LocalizedText `json:"name"`
+	Description      LocalizedText `json:"description"`
+	LogoURL          string        `json:"logoUrl,omitempty"`
+	FaviconURL       string        `json:"faviconUrl,omitempty"`
+	GoogleAnalytics  string        `json:"googleAnalytics,omitempty"`
+	CustomDomain     string        `json:"customDomain,omitempty"`
+	DefaultLanguage  string        `json:"language,omitempty"`
+	Languages        []string      `json:"languages,omitempty"`
+	*PageTheme
+	*PageAccess
 }
 
 // CreateStatusPage, GetStatusPage, UpdateStatusPage, DeleteStatusPage
@@ -341,6 +515,12 @@ func (c *Client) CreateStatusPage(page *Page) (*Page, error) {
 		FaviconURL:       page.FaviconURL,
 		CustomDomain:     page.CustomDomain,
 		GoogleAnalytics:  page.GoogleAnalytics,
+		Description:      page.Description,
+		DefaultLanguage:  page.DefaultLanguage,
+		Languages:        page.Languages,
+		Translations:     page.Translations,
+		PageTheme:        page.PageTheme,
+		PageAccess:       page.PageAccess,
 	}
 
 	return created, nil
@@ -364,13 +544,19 @@ func (c *Client) GetStatusPage(pageID string) (*Page, error) {
 	// Convert PageGetResponse to Page
 	page := &Page{
 		ID:               resp.ID,
-		Name:             resp.Name,
+		Name:             resp.Name.Default,
+		Description:      resp.Description.Default,
+		DefaultLanguage:  resp.DefaultLanguage,
+		Languages:        resp.Languages,
+		Translations:     localizedPageTranslations(resp.Name, resp.Description),
 		WorkspaceSlug:    resp.WorkspaceSlug,
 		WorkspaceID:      resp.WorkspaceID,
 		LogoURL:          resp.LogoURL,
 		FaviconURL:       resp.FaviconURL,
 		GoogleAnalytics:  resp.GoogleAnalytics,
 		CustomDomain:     resp.CustomDomain,
+		PageTheme:        resp.PageTheme,
+		PageAccess:       resp.PageAccess,
 	}
 
 	return page, nil
@@ -378,6 +564,42 @@ func (c *Client) GetStatusPage(pageID string) (*Page, error) {
 	// return nil, fmt.Errorf("GetStatusPage is not supported by the Instatus API at this time")
 }
 
+// ListStatusPages retrieves every status page the API key has access to
+func (c *Client) ListStatusPages() ([]Page, error) {
+	resp, err := listPaginated[PageGetResponse](c, "/v2/pages")
+	if err != nil {
+		return nil, err
+	}
+
+	pages := make([]Page, 0, len(resp))
+	for _, item := range resp {
+		pages = append(pages, Page{
+			ID:            item.ID,
+			Name:          item.Name.Default,
+			WorkspaceSlug: item.WorkspaceSlug,
+			WorkspaceID:   item.WorkspaceID,
+		})
+	}
+
+	return pages, nil
+}
+
+// FindStatusPageBySlug looks up a status page by its subdomain/workspace slug
+func (c *Client) FindStatusPageBySlug(slug string) (*Page, error) {
+	pages, err := c.ListStatusPages()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range pages {
+		if pages[i].WorkspaceSlug == slug {
+			return &pages[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no status page found with slug %q", slug)
+}
+
 // UpdateStatusPage updates an existing status page
 func (c *Client) UpdateStatusPage(pageID string, page *PageUpdate) (*PageUpdate, error) {
 	endpoint := fmt.Sprintf("/v2/%s", pageID)
@@ -402,24 +624,1311 @@ func (c *Client) UpdateStatusPage(pageID string, page *PageUpdate) (*PageUpdate,
 		FaviconURL:       resp.FaviconURL,
 		GoogleAnalytics:  resp.GoogleAnalytics,
 		CustomDomain:     resp.CustomDomain,
+		Description:      resp.Description.Default,
+		DefaultLanguage:  resp.DefaultLanguage,
+		Languages:        resp.Languages,
+		Translations:     localizedPageTranslations(resp.Name, resp.Description),
+		PageTheme:        resp.PageTheme,
+		PageAccess:       resp.PageAccess,
 	}
 
 	return updated, nil
 
 }
 
+// localizedPageTranslations collects the per-language values of a localized
+// page name and description
+func localizedPageTranslations(name, description LocalizedText) *PageTranslations {
+	if len(name.Translations) == 0 && len(description.Translations) == 0 {
+		return nil
+	}
+
+	return &PageTranslations{
+		Name:        name.Translations,
+		Description: description.Translations,
+	}
+}
+
 type PageDeleteResponse struct {
 	ID string `json:"id"`
 }
 
-// DeleteStatusPage deletes a status page
-func (c *Client) DeleteStatusPage(pageID string, workspaceID string) error {
-	// Status Page Deletion
+// DeleteStatusPage deletes a status page, leaving its workspace in place
+func (c *Client) DeleteStatusPage(pageID string) error {
 	endpoint := fmt.Sprintf("/v2/%s", pageID)
 
 	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Workspaces
+// Workspace represents an Instatus workspace, which holds one or more status pages
+type Workspace struct {
+	ID    string `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	Email string `json:"email,omitempty"`
+}
+
+// CreateWorkspace creates a new workspace
+func (c *Client) CreateWorkspace(workspace *Workspace) (*Workspace, error) {
+	endpoint := "/v1/workspaces"
+
+	respBody, err := c.doRequest("POST", endpoint, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Workspace
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetWorkspace retrieves a workspace by ID
+func (c *Client) GetWorkspace(workspaceID string) (*Workspace, error) {
+	endpoint := fmt.Sprintf("/v1/workspaces/%s", workspaceID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var workspace Workspace
+	if err := json.Unmarshal(respBody, &workspace); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &workspace, nil
+}
+
+// UpdateWorkspace updates an existing workspace
+func (c *Client) UpdateWorkspace(workspaceID string, workspace *Workspace) (*Workspace, error) {
+	endpoint := fmt.Sprintf("/v1/workspaces/%s", workspaceID)
+
+	respBody, err := c.doRequest("PUT", endpoint, workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Workspace
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteWorkspace deletes a workspace and every page it holds
+func (c *Client) DeleteWorkspace(workspaceID string) error {
+	endpoint := fmt.Sprintf("/v1/workspaces/%s", workspaceID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Team Members
+// TeamMember represents a user with access to a workspace or a status page
+type TeamMember struct {
+	ID      string `json:"id,omitempty"`
+	Email   string `json:"email"`
+	Role    string `json:"role"`
+	Pending bool   `json:"pending,omitempty"` // Invitation not accepted yet
+}
+
+// teamMembersEndpoint returns the team endpoint of a workspace, or of a
+// status page when workspaceID is empty
+func teamMembersEndpoint(workspaceID string, pageID string) string {
+	if workspaceID != "" {
+		return fmt.Sprintf("/v1/workspaces/%s/team-members", workspaceID)
+	}
+	return fmt.Sprintf("/v2/%s/team-members", pageID)
+}
+
+// CreateTeamMember invites a user to a workspace or status page
+func (c *Client) CreateTeamMember(workspaceID string, pageID string, member *TeamMember) (*TeamMember, error) {
+	respBody, err := c.doRequest("POST", teamMembersEndpoint(workspaceID, pageID), member)
+	if err != nil {
+		return nil, err
+	}
+
+	var created TeamMember
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetTeamMember retrieves a team member by ID
+func (c *Client) GetTeamMember(workspaceID string, pageID string, memberID string) (*TeamMember, error) {
+	endpoint := fmt.Sprintf("%s/%s", teamMembersEndpoint(workspaceID, pageID), memberID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var member TeamMember
+	if err := json.Unmarshal(respBody, &member); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &member, nil
+}
+
+// UpdateTeamMember changes the role of a team member
+func (c *Client) UpdateTeamMember(workspaceID string, pageID string, memberID string, member *TeamMember) (*TeamMember, error) {
+	endpoint := fmt.Sprintf("%s/%s", teamMembersEndpoint(workspaceID, pageID), memberID)
+
+	respBody, err := c.doRequest("PUT", endpoint, member)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated TeamMember
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteTeamMember revokes the access of a team member, or withdraws a
+// pending invitation
+func (c *Client) DeleteTeamMember(workspaceID string, pageID string, memberID string) error {
+	endpoint := fmt.Sprintf("%s/%s", teamMembersEndpoint(workspaceID, pageID), memberID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Page Notification Settings
+// PageNotificationSettings represents the subscription channels offered on a
+// status page and how subscriber notifications are sent
+type PageNotificationSettings struct {
+	SubscribeByEmail   bool   `json:"subscribeByEmail"`
+	SubscribeBySms     bool   `json:"subscribeBySms"`
+	SubscribeBySlack   bool   `json:"subscribeBySlack"`
+	SubscribeByTeams   bool   `json:"subscribeByTeams"`
+	SubscribeByWebhook bool   `json:"subscribeByWebhook"`
+	SubscribeByRss     bool   `json:"subscribeByRss"`
+	SenderName         string `json:"senderName,omitempty"`
+	ReplyTo            string `json:"replyTo"`
+	Language           string `json:"language,omitempty"`
+}
+
+// GetPageNotificationSettings retrieves the notification settings of a status page
+func (c *Client) GetPageNotificationSettings(pageID string) (*PageNotificationSettings, error) {
+	endpoint := fmt.Sprintf("/v2/%s/notification-settings", pageID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var settings PageNotificationSettings
+	if err := json.Unmarshal(respBody, &settings); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &settings, nil
+}
+
+// UpdatePageNotificationSettings updates the notification settings of a status page
+func (c *Client) UpdatePageNotificationSettings(pageID string, settings *PageNotificationSettings) (*PageNotificationSettings, error) {
+	endpoint := fmt.Sprintf("/v2/%s/notification-settings", pageID)
+
+	respBody, err := c.doRequest("PUT", endpoint, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated PageNotificationSettings
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// Custom Domains
+// CustomDomain represents the custom domain of a status page along with the
+// DNS records Instatus needs to verify it and issue a certificate
+type CustomDomain struct {
+	Domain             string      `json:"domain"`
+	VerificationStatus string      `json:"verificationStatus,omitempty"` // PENDING, VERIFIED, FAILED
+	SSLStatus          string      `json:"sslStatus,omitempty"`          // PENDING, ISSUED, FAILED
+	VerificationError  string      `json:"verificationError,omitempty"`
+	DNSRecords         []DNSRecord `json:"dnsRecords,omitempty"`
+}
+
+// DNSRecord represents a DNS record required by Instatus
+type DNSRecord struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// CreateCustomDomain sets the custom domain of a status page
+func (c *Client) CreateCustomDomain(pageID string, domain *CustomDomain) (*CustomDomain, error) {
+	endpoint := fmt.Sprintf("/v2/%s/custom-domain", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, domain)
+	if err != nil {
+		return nil, err
+	}
+
+	var created CustomDomain
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetCustomDomain retrieves the custom domain of a status page and its verification status
+func (c *Client) GetCustomDomain(pageID string) (*CustomDomain, error) {
+	endpoint := fmt.Sprintf("/v2/%s/custom-domain", pageID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var domain CustomDomain
+	if err := json.Unmarshal(respBody, &domain); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
 
-	// Workspace Deletion - DELETE /v1/workspaces/:workspace_id
-	_, err = c.doRequest("DELETE", fmt.Sprintf("/v1/workspaces/%s", workspaceID), nil)
+	return &domain, nil
+}
+
+// DeleteCustomDomain removes the custom domain of a status page
+func (c *Client) DeleteCustomDomain(pageID string) error {
+	endpoint := fmt.Sprintf("/v2/%s/custom-domain", pageID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
 	return err
 }
+
+// Incidents
+// Incident represents an Instatus incident
+type Incident struct {
+	ID         string              `json:"id,omitempty"`
+	Name       string              `json:"name"`
+	Message    string              `json:"message"`
+	Status     string              `json:"status"`
+	Started    string              `json:"started,omitempty"`
+	Resolved   string              `json:"-"`
+	Notify     bool                `json:"notify"`
+	Components []string            `json:"components"` // Affected component IDs
+	Statuses   []AffectedComponent `json:"statuses"`
+}
+
+// AffectedComponent sets the status of a component affected by an incident
+// or maintenance
+type AffectedComponent struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// IncidentResponse represents an Instatus incident response with nested
+// components and updates
+type IncidentResponse struct {
+	ID              string                   `json:"id"`
+	Name            string                   `json:"name"`
+	Status          string                   `json:"status"`
+	Started         string                   `json:"started,omitempty"`
+	Resolved        string                   `json:"resolved,omitempty"`
+	Components      []AffectedComponentState `json:"components"`
+	IncidentUpdates []TimelineUpdateResponse `json:"incidentUpdates"`
+}
+
+// AffectedComponentState is an affected component as returned by the API
+type AffectedComponentState struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// TimelineUpdate represents an update posted on an incident or a maintenance
+type TimelineUpdate struct {
+	ID           string              `json:"id,omitempty"`
+	Message      string              `json:"message"`
+	Status       string              `json:"status"`
+	Started      string              `json:"started,omitempty"`
+	Notify       bool                `json:"notify"`
+	Components   []string            `json:"components"` // Affected component IDs
+	Statuses     []AffectedComponent `json:"statuses"`
+	Translations *UpdateTranslations `json:"translations,omitempty"`
+}
+
+// UpdateTranslations holds the localized message of an incident or
+// maintenance update, keyed by language code
+type UpdateTranslations struct {
+	Message map[string]string `json:"message,omitempty"`
+}
+
+// TimelineUpdateResponse represents an incident or maintenance update as
+// returned by the API
+type TimelineUpdateResponse struct {
+	ID           string                   `json:"id"`
+	Message      string                   `json:"message"`
+	Status       string                   `json:"status"`
+	Started      string                   `json:"started,omitempty"`
+	Components   []AffectedComponentState `json:"components"`
+	Translations *UpdateTranslations      `json:"translations,omitempty"`
+}
+
+func (r *TimelineUpdateResponse) toTimelineUpdate() *TimelineUpdate {
+	update := &TimelineUpdate{
+		ID:           r.ID,
+		Message:      r.Message,
+		Status:       r.Status,
+		Started:      r.Started,
+		Components:   []string{},
+		Statuses:     []AffectedComponent{},
+		Translations: r.Translations,
+	}
+
+	for _, component := range r.Components {
+		update.Components = append(update.Components, component.ID)
+		update.Statuses = append(update.Statuses, AffectedComponent{
+			ID:     component.ID,
+			Status: component.Status,
+		})
+	}
+
+	return update
+}
+
+// toIncident converts the response, taking the message from the first update
+func (r *IncidentResponse) toIncident() *Incident {
+	incident := &Incident{
+		ID:         r.ID,
+		Name:       r.Name,
+		Status:     r.Status,
+		Started:    r.Started,
+		Resolved:   r.Resolved,
+		Components: []string{},
+		Statuses:   []AffectedComponent{},
+	}
+
+	for _, component := range r.Components {
+		incident.Components = append(incident.Components, component.ID)
+		incident.Statuses = append(incident.Statuses, AffectedComponent{
+			ID:     component.ID,
+			Status: component.Status,
+		})
+	}
+
+	if len(r.IncidentUpdates) > 0 {
+		first := r.IncidentUpdates[0]
+		for _, update := range r.IncidentUpdates[1:] {
+			if update.Started != "" && update.Started < first.Started {
+				first = update
+			}
+		}
+		incident.Message = first.Message
+	}
+
+	return incident
+}
+
+// CreateIncident creates a new incident
+func (c *Client) CreateIncident(pageID string, incident *Incident) (*Incident, error) {
+	endpoint := fmt.Sprintf("/v1/%s/incidents", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, incident)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp IncidentResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toIncident(), nil
+}
+
+// GetIncident retrieves an incident by ID
+func (c *Client) GetIncident(pageID string, incidentID string) (*Incident, error) {
+	endpoint := fmt.Sprintf("/v1/%s/incidents/%s", pageID, incidentID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp IncidentResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toIncident(), nil
+}
+
+// ListIncidents retrieves every incident of a status page
+func (c *Client) ListIncidents(pageID string) ([]Incident, error) {
+	resp, err := listPaginated[IncidentResponse](c, fmt.Sprintf("/v1/%s/incidents", pageID))
+	if err != nil {
+		return nil, err
+	}
+
+	incidents := make([]Incident, 0, len(resp))
+	for i := range resp {
+		incidents = append(incidents, *resp[i].toIncident())
+	}
+
+	return incidents, nil
+}
+
+// UpdateIncident updates an existing incident
+func (c *Client) UpdateIncident(pageID string, incidentID string, incident *Incident) (*Incident, error) {
+	endpoint := fmt.Sprintf("/v1/%s/incidents/%s", pageID, incidentID)
+
+	respBody, err := c.doRequest("PUT", endpoint, incident)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp IncidentResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toIncident(), nil
+}
+
+// DeleteIncident deletes an incident
+func (c *Client) DeleteIncident(pageID string, incidentID string) error {
+	endpoint := fmt.Sprintf("/v1/%s/incidents/%s", pageID, incidentID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// CreateIncidentUpdate posts an update on an incident
+func (c *Client) CreateIncidentUpdate(pageID string, incidentID string, update *TimelineUpdate) (*TimelineUpdate, error) {
+	endpoint := fmt.Sprintf("/v1/%s/incidents/%s/incident-updates", pageID, incidentID)
+
+	respBody, err := c.doRequest("POST", endpoint, update)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp TimelineUpdateResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toTimelineUpdate(), nil
+}
+
+// GetIncidentUpdate retrieves an incident update by ID
+func (c *Client) GetIncidentUpdate(pageID string, incidentID string, updateID string) (*TimelineUpdate, error) {
+	endpoint := fmt.Sprintf("/v1/%s/incidents/%s/incident-updates/%s", pageID, incidentID, updateID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp TimelineUpdateResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toTimelineUpdate(), nil
+}
+
+// DeleteIncidentUpdate deletes a single incident update
+func (c *Client) DeleteIncidentUpdate(pageID string, incidentID string, updateID string) error {
+	endpoint := fmt.Sprintf("/v1/%s/incidents/%s/incident-updates/%s", pageID, incidentID, updateID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Maintenances
+// Maintenance represents a scheduled maintenance window
+type Maintenance struct {
+	ID           string                   `json:"id,omitempty"`
+	Name         string                   `json:"name"`
+	Message      string                   `json:"message"`
+	Status       string                   `json:"status,omitempty"` // NOTSTARTEDYET, INPROGRESS, COMPLETED
+	Start        string                   `json:"start"`
+	End          string                   `json:"end"`
+	AutoStart    bool                     `json:"autoStart"`
+	AutoEnd      bool                     `json:"autoEnd"`
+	Notify       bool                     `json:"notify"`
+	NotifyBefore []int                    `json:"notifyBefore"` // Reminder lead times in minutes
+	Components   []string                 `json:"components"`   // Affected component IDs
+	Statuses     []AffectedComponent      `json:"statuses"`
+	Translations *MaintenanceTranslations `json:"translations,omitempty"`
+}
+
+// MaintenanceTranslations holds the localized name and message of a
+// maintenance, keyed by language code
+type MaintenanceTranslations struct {
+	Name    map[string]string `json:"name,omitempty"`
+	Message map[string]string `json:"message,omitempty"`
+}
+
+// MaintenanceResponse represents an Instatus maintenance response with
+// nested components and updates
+type MaintenanceResponse struct {
+	ID                 string                   `json:"id"`
+	Name               string                   `json:"name"`
+	Status             string                   `json:"status"`
+	Start              string                   `json:"start"`
+	End                string                   `json:"end"`
+	AutoStart          bool                     `json:"autoStart"`
+	AutoEnd            bool                     `json:"autoEnd"`
+	NotifyBefore       []int                    `json:"notifyBefore"`
+	Components         []AffectedComponentState `json:"components"`
+	MaintenanceUpdates []TimelineUpdateResponse `json:"maintenanceUpdates"`
+	Translations       *MaintenanceTranslations `json:"translations,omitempty"`
+}
+
+// toMaintenance converts the response, taking the message from the first update
+func (r *MaintenanceResponse) toMaintenance() *Maintenance {
+	maintenance := &Maintenance{
+		ID:           r.ID,
+		Name:         r.Name,
+		Status:       r.Status,
+		Start:        r.Start,
+		End:          r.End,
+		AutoStart:    r.AutoStart,
+		AutoEnd:      r.AutoEnd,
+		NotifyBefore: r.NotifyBefore,
+		Components:   []string{},
+		Statuses:     []AffectedComponent{},
+		Translations: r.Translations,
+	}
+
+	for _, component := range r.Components {
+		maintenance.Components = append(maintenance.Components, component.ID)
+		maintenance.Statuses = append(maintenance.Statuses, AffectedComponent{
+			ID:     component.ID,
+			Status: component.Status,
+		})
+	}
+
+	if len(r.MaintenanceUpdates) > 0 {
+		first := r.MaintenanceUpdates[0]
+		for _, update := range r.MaintenanceUpdates[1:] {
+			if update.Started != "" && update.Started < first.Started {
+				first = update
+			}
+		}
+		maintenance.Message = first.Message
+	}
+
+	return maintenance
+}
+
+// CreateMaintenance schedules a new maintenance
+func (c *Client) CreateMaintenance(pageID string, maintenance *Maintenance) (*Maintenance, error) {
+	endpoint := fmt.Sprintf("/v1/%s/maintenances", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, maintenance)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp MaintenanceResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toMaintenance(), nil
+}
+
+// GetMaintenance retrieves a maintenance by ID
+func (c *Client) GetMaintenance(pageID string, maintenanceID string) (*Maintenance, error) {
+	endpoint := fmt.Sprintf("/v1/%s/maintenances/%s", pageID, maintenanceID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp MaintenanceResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toMaintenance(), nil
+}
+
+// UpdateMaintenance updates an existing maintenance
+func (c *Client) UpdateMaintenance(pageID string, maintenanceID string, maintenance *Maintenance) (*Maintenance, error) {
+	endpoint := fmt.Sprintf("/v1/%s/maintenances/%s", pageID, maintenanceID)
+
+	respBody, err := c.doRequest("PUT", endpoint, maintenance)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp MaintenanceResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toMaintenance(), nil
+}
+
+// DeleteMaintenance deletes a maintenance
+func (c *Client) DeleteMaintenance(pageID string, maintenanceID string) error {
+	endpoint := fmt.Sprintf("/v1/%s/maintenances/%s", pageID, maintenanceID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// CreateMaintenanceUpdate posts an update on a maintenance
+func (c *Client) CreateMaintenanceUpdate(pageID string, maintenanceID string, update *TimelineUpdate) (*TimelineUpdate, error) {
+	endpoint := fmt.Sprintf("/v1/%s/maintenances/%s/maintenance-updates", pageID, maintenanceID)
+
+	respBody, err := c.doRequest("POST", endpoint, update)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp TimelineUpdateResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toTimelineUpdate(), nil
+}
+
+// GetMaintenanceUpdate retrieves a maintenance update by ID
+func (c *Client) GetMaintenanceUpdate(pageID string, maintenanceID string, updateID string) (*TimelineUpdate, error) {
+	endpoint := fmt.Sprintf("/v1/%s/maintenances/%s/maintenance-updates/%s", pageID, maintenanceID, updateID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp TimelineUpdateResponse
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return resp.toTimelineUpdate(), nil
+}
+
+// DeleteMaintenanceUpdate deletes a single maintenance update
+func (c *Client) DeleteMaintenanceUpdate(pageID string, maintenanceID string, updateID string) error {
+	endpoint := fmt.Sprintf("/v1/%s/maintenances/%s/maintenance-updates/%s", pageID, maintenanceID, updateID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Templates
+// Template holds the default wording, status and affected components of an
+// incident or maintenance
+type Template struct {
+	ID         string              `json:"id,omitempty"`
+	Type       string              `json:"type"` // INCIDENT or MAINTENANCE
+	Name       string              `json:"name"`
+	Title      string              `json:"title"`
+	Message    string              `json:"message"`
+	Status     string              `json:"status,omitempty"`
+	Components []string            `json:"components"` // Affected component IDs
+	Statuses   []AffectedComponent `json:"statuses"`
+}
+
+// CreateTemplate creates a new incident or maintenance template
+func (c *Client) CreateTemplate(pageID string, template *Template) (*Template, error) {
+	endpoint := fmt.Sprintf("/v1/%s/templates", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, template)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Template
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetTemplate retrieves a template by ID
+func (c *Client) GetTemplate(pageID string, templateID string) (*Template, error) {
+	endpoint := fmt.Sprintf("/v1/%s/templates/%s", pageID, templateID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var template Template
+	if err := json.Unmarshal(respBody, &template); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &template, nil
+}
+
+// UpdateTemplate updates an existing template
+func (c *Client) UpdateTemplate(pageID string, templateID string, template *Template) (*Template, error) {
+	endpoint := fmt.Sprintf("/v1/%s/templates/%s", pageID, templateID)
+
+	respBody, err := c.doRequest("PUT", endpoint, template)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Template
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteTemplate deletes a template. Incidents and maintenances created
+// from it are not affected.
+func (c *Client) DeleteTemplate(pageID string, templateID string) error {
+	endpoint := fmt.Sprintf("/v1/%s/templates/%s", pageID, templateID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Subscribers
+// Subscriber represents a subscriber of a status page. Exactly one of
+// Email, Phone, Webhook or Slack is the address notifications go to;
+// webhook subscribers also carry a contact Email.
+type Subscriber struct {
+	ID          string   `json:"id,omitempty"`
+	Email       string   `json:"email,omitempty"`
+	Phone       string   `json:"phone,omitempty"`
+	Webhook     string   `json:"webhook,omitempty"`
+	Slack       string   `json:"slack,omitempty"`
+	All         bool     `json:"all"`
+	Components  []string `json:"components,omitempty"` // Component IDs, when not subscribed to all
+	Language    string   `json:"language,omitempty"`
+	AutoConfirm bool     `json:"autoConfirm,omitempty"`
+	Confirmed   bool     `json:"confirmed,omitempty"`
+}
+
+// CreateSubscriber adds a subscriber to a status page
+func (c *Client) CreateSubscriber(pageID string, subscriber *Subscriber) (*Subscriber, error) {
+	endpoint := fmt.Sprintf("/v1/%s/subscribers", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, subscriber)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Subscriber
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetSubscriber retrieves a subscriber by ID
+func (c *Client) GetSubscriber(pageID string, subscriberID string) (*Subscriber, error) {
+	endpoint := fmt.Sprintf("/v1/%s/subscribers/%s", pageID, subscriberID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var subscriber Subscriber
+	if err := json.Unmarshal(respBody, &subscriber); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &subscriber, nil
+}
+
+// ListSubscribers retrieves every subscriber of a status page
+func (c *Client) ListSubscribers(pageID string) ([]Subscriber, error) {
+	return listPaginated[Subscriber](c, fmt.Sprintf("/v1/%s/subscribers", pageID))
+}
+
+// DeleteSubscriber deletes a subscriber
+func (c *Client) DeleteSubscriber(pageID string, subscriberID string) error {
+	endpoint := fmt.Sprintf("/v1/%s/subscribers/%s", pageID, subscriberID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+const (
+	// subscriberBatchSize is how many subscriber requests are sent before
+	// pausing, to stay within the API rate limit
+	subscriberBatchSize     = 20
+	subscriberBatchInterval = 2 * time.Second
+)
+
+// IsRateLimited reports whether err is an API error for a throttled request
+func IsRateLimited(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTooManyRequests
+}
+
+// maxRetries is how many times a request is retried after the first attempt
+const maxRetries = 3
+
+// retryBackoff is the wait before the first retry, doubled on every
+// further attempt
+var retryBackoff = 2 * time.Second
+
+// retryWithBackoff calls fn, backing off and retrying while shouldRetry
+// reports its error as transient
+func retryWithBackoff(shouldRetry func(error) bool, fn func() error) error {
+	wait := retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := fn()
+		if err == nil || !shouldRetry(err) || attempt == maxRetries {
+			return err
+		}
+		time.Sleep(wait)
+		wait *= 2
+	}
+}
+
+// retryRateLimited calls fn, backing off and retrying while it is throttled
+func retryRateLimited(fn func() error) error {
+	return retryWithBackoff(IsRateLimited, fn)
+}
+
+// SubscriberResult is the outcome of adding a single subscriber in a batch
+type SubscriberResult struct {
+	Subscriber *Subscriber
+	Err        error
+}
+
+// CreateSubscribers adds subscribers in batches, pausing between batches
+// and retrying throttled requests. A failure only affects its own entry;
+// results are returned in the order of subscribers.
+func (c *Client) CreateSubscribers(pageID string, subscribers []*Subscriber) []SubscriberResult {
+	results := make([]SubscriberResult, len(subscribers))
+	for i, subscriber := range subscribers {
+		if i > 0 && i%subscriberBatchSize == 0 {
+			time.Sleep(subscriberBatchInterval)
+		}
+		results[i].Err = retryRateLimited(func() error {
+			created, err := c.CreateSubscriber(pageID, subscriber)
+			results[i].Subscriber = created
+			return err
+		})
+	}
+	return results
+}
+
+// DeleteSubscribers removes subscribers in batches, pausing between
+// batches and retrying throttled requests. Subscribers that no longer
+// exist are not reported; errors are returned in the order of subscriberIDs.
+func (c *Client) DeleteSubscribers(pageID string, subscriberIDs []string) []error {
+	errs := make([]error, len(subscriberIDs))
+	for i, subscriberID := range subscriberIDs {
+		if i > 0 && i%subscriberBatchSize == 0 {
+			time.Sleep(subscriberBatchInterval)
+		}
+		err := retryRateLimited(func() error {
+			return c.DeleteSubscriber(pageID, subscriberID)
+		})
+		if err != nil && !IsNotFound(err) {
+			errs[i] = err
+		}
+	}
+	return errs
+}
+
+// Monitors
+// Monitor checks a target and sets the status of a component when it fails.
+// Heartbeat monitors instead expect check-ins on their URL and fail when
+// none arrives within the interval plus the grace period.
+type Monitor struct {
+	ID                  string            `json:"id,omitempty"`
+	Name                string            `json:"name"`
+	Type                string            `json:"type"` // HTTP, KEYWORD, PING, PORT or HEARTBEAT
+	Target              string            `json:"target,omitempty"`
+	Port                int               `json:"port,omitempty"`
+	Interval            int               `json:"interval"` // Seconds between checks, or expected period of check-ins
+	Timeout             int               `json:"timeout,omitempty"`
+	GracePeriod         int               `json:"gracePeriod"` // Sent even when 0, which disables the grace period
+	ExpectedStatusCodes []int             `json:"expectedStatusCodes,omitempty"`
+	Keyword             string            `json:"keyword,omitempty"`
+	Headers             map[string]string `json:"headers"` // Sent even when empty or null, which clears the headers
+	Regions             []string          `json:"regions,omitempty"`
+	ComponentID         string            `json:"componentId,omitempty"`
+	FailureStatus       string            `json:"failureStatus,omitempty"`
+	CheckInURL          string            `json:"checkInUrl,omitempty"`
+}
+
+// CreateMonitor adds a monitor to a status page
+func (c *Client) CreateMonitor(pageID string, monitor *Monitor) (*Monitor, error) {
+	endpoint := fmt.Sprintf("/v2/%s/monitors", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Monitor
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetMonitor retrieves a monitor by ID
+func (c *Client) GetMonitor(pageID string, monitorID string) (*Monitor, error) {
+	endpoint := fmt.Sprintf("/v2/%s/monitors/%s", pageID, monitorID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var monitor Monitor
+	if err := json.Unmarshal(respBody, &monitor); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &monitor, nil
+}
+
+// UpdateMonitor updates an existing monitor
+func (c *Client) UpdateMonitor(pageID string, monitorID string, monitor *Monitor) (*Monitor, error) {
+	endpoint := fmt.Sprintf("/v2/%s/monitors/%s", pageID, monitorID)
+
+	respBody, err := c.doRequest("PUT", endpoint, monitor)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Monitor
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteMonitor deletes a monitor
+func (c *Client) DeleteMonitor(pageID string, monitorID string) error {
+	endpoint := fmt.Sprintf("/v2/%s/monitors/%s", pageID, monitorID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Notification Integrations
+// NotificationIntegration posts page events to a chat channel or webhook
+type NotificationIntegration struct {
+	ID           string `json:"id,omitempty"`
+	Type         string `json:"type"` // SLACK, DISCORD, TEAMS or WEBHOOK
+	URL          string `json:"url,omitempty"`
+	Channel      string `json:"channel,omitempty"`
+	Incidents    bool   `json:"incidents"`
+	Maintenances bool   `json:"maintenances"`
+	Components   bool   `json:"components"` // Component status changes
+}
+
+// CreateNotificationIntegration adds a notification integration to a status page
+func (c *Client) CreateNotificationIntegration(pageID string, integration *NotificationIntegration) (*NotificationIntegration, error) {
+	endpoint := fmt.Sprintf("/v2/%s/integrations", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	var created NotificationIntegration
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetNotificationIntegration retrieves a notification integration by ID
+func (c *Client) GetNotificationIntegration(pageID string, integrationID string) (*NotificationIntegration, error) {
+	endpoint := fmt.Sprintf("/v2/%s/integrations/%s", pageID, integrationID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var integration NotificationIntegration
+	if err := json.Unmarshal(respBody, &integration); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &integration, nil
+}
+
+// UpdateNotificationIntegration updates an existing notification integration
+func (c *Client) UpdateNotificationIntegration(pageID string, integrationID string, integration *NotificationIntegration) (*NotificationIntegration, error) {
+	endpoint := fmt.Sprintf("/v2/%s/integrations/%s", pageID, integrationID)
+
+	respBody, err := c.doRequest("PUT", endpoint, integration)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated NotificationIntegration
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteNotificationIntegration deletes a notification integration
+func (c *Client) DeleteNotificationIntegration(pageID string, integrationID string) error {
+	endpoint := fmt.Sprintf("/v2/%s/integrations/%s", pageID, integrationID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Automations
+// Automation changes component statuses from alerts sent by a monitoring tool
+type Automation struct {
+	ID             string           `json:"id,omitempty"`
+	Name           string           `json:"name"`
+	Source         string           `json:"source"` // PAGERDUTY, OPSGENIE, DATADOG, GRAFANA or EMAIL
+	Rules          []AutomationRule `json:"rules"`
+	Components     []string         `json:"components"`
+	Status         string           `json:"status"`         // Component status while the alert is open
+	ResolvedStatus string           `json:"resolvedStatus"` // Component status once the alert resolves
+	Email          string           `json:"email,omitempty"`
+	WebhookURL     string           `json:"webhookUrl,omitempty"`
+}
+
+// AutomationRule matches a field of incoming alerts
+type AutomationRule struct {
+	Field    string `json:"field"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// CreateAutomation adds an automation to a status page
+func (c *Client) CreateAutomation(pageID string, automation *Automation) (*Automation, error) {
+	endpoint := fmt.Sprintf("/v2/%s/automations", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, automation)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Automation
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetAutomation retrieves an automation by ID
+func (c *Client) GetAutomation(pageID string, automationID string) (*Automation, error) {
+	endpoint := fmt.Sprintf("/v2/%s/automations/%s", pageID, automationID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var automation Automation
+	if err := json.Unmarshal(respBody, &automation); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &automation, nil
+}
+
+// UpdateAutomation updates an existing automation
+func (c *Client) UpdateAutomation(pageID string, automationID string, automation *Automation) (*Automation, error) {
+	endpoint := fmt.Sprintf("/v2/%s/automations/%s", pageID, automationID)
+
+	respBody, err := c.doRequest("PUT", endpoint, automation)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Automation
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteAutomation deletes an automation
+func (c *Client) DeleteAutomation(pageID string, automationID string) error {
+	endpoint := fmt.Sprintf("/v2/%s/automations/%s", pageID, automationID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// Metrics
+// Metric represents a custom metric displayed on a status page
+type Metric struct {
+	ID            string `json:"id,omitempty"`
+	Name          string `json:"name"`
+	Suffix        string `json:"suffix"`
+	DisplayType   string `json:"type,omitempty"`
+	DecimalPlaces int    `json:"decimalPlaces"`
+	Public        bool   `json:"public"`
+	Order         int    `json:"order,omitempty"`
+}
+
+// CreateMetric creates a new custom metric
+func (c *Client) CreateMetric(pageID string, metric *Metric) (*Metric, error) {
+	endpoint := fmt.Sprintf("/v1/%s/metrics", pageID)
+
+	respBody, err := c.doRequest("POST", endpoint, metric)
+	if err != nil {
+		return nil, err
+	}
+
+	var created Metric
+	if err := json.Unmarshal(respBody, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &created, nil
+}
+
+// GetMetric retrieves a custom metric by ID
+func (c *Client) GetMetric(pageID string, metricID string) (*Metric, error) {
+	endpoint := fmt.Sprintf("/v1/%s/metrics/%s", pageID, metricID)
+
+	respBody, err := c.doRequest("GET", endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var metric Metric
+	if err := json.Unmarshal(respBody, &metric); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &metric, nil
+}
+
+// UpdateMetric updates an existing custom metric
+func (c *Client) UpdateMetric(pageID string, metricID string, metric *Metric) (*Metric, error) {
+	endpoint := fmt.Sprintf("/v1/%s/metrics/%s", pageID, metricID)
+
+	respBody, err := c.doRequest("PUT", endpoint, metric)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated Metric
+	if err := json.Unmarshal(respBody, &updated); err != nil {
+		return nil, fmt.Errorf("error unmarshaling response: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// DeleteMetric deletes a custom metric and its data points
+func (c *Client) DeleteMetric(pageID string, metricID string) error {
+	endpoint := fmt.Sprintf("/v1/%s/metrics/%s", pageID, metricID)
+
+	_, err := c.doRequest("DELETE", endpoint, nil)
+	return err
+}
+
+// MetricDataPoint is a single value of a custom metric
+type MetricDataPoint struct {
+	Timestamp time.Time
+	Value     float64
+}
+
+// MarshalJSON sends the timestamp as Unix seconds
+func (p MetricDataPoint) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Timestamp int64   `json:"timestamp"`
+		Value     float64 `json:"value"`
+	}{p.Timestamp.Unix(), p.Value})
+}
+
+const (
+	// metricDataPointBatchSize is the most data points sent in one request
+	metricDataPointBatchSize = 100
+	// metricDataPointMaxSkew is how far ahead of the local clock a data
+	// point may be timestamped
+	metricDataPointMaxSkew = 5 * time.Minute
+)
+
+// validateMetricDataPoints checks every data point before any is sent
+func validateMetricDataPoints(points []MetricDataPoint, now time.Time) error {
+	var errs []error
+	for i, point := range points {
+		switch {
+		case point.Timestamp.IsZero():
+			errs = append(errs, fmt.Errorf("data point %d: timestamp is required", i))
+		case point.Timestamp.After(now.Add(metricDataPointMaxSkew)):
+			errs = append(errs, fmt.Errorf("data point %d: timestamp %s is in the future", i, point.Timestamp.Format(time.RFC3339)))
+		}
+		if math.IsNaN(point.Value) || math.IsInf(point.Value, 0) {
+			errs = append(errs, fmt.Errorf("data point %d: value must be a finite number", i))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SubmitMetricDataPoint adds a single data point to a custom metric
+func (c *Client) SubmitMetricDataPoint(pageID string, metricID string, point MetricDataPoint) error {
+	return c.SubmitMetricDataPoints(pageID, metricID, []MetricDataPoint{point})
+}
+
+// SubmitMetricDataPoints adds data points to a custom metric. All points
+// are validated first, then sent in batches, retrying throttled requests
+// with backoff. Server errors are not retried, as the points may have been
+// recorded. When a batch fails, later batches are not sent and the error
+// reports how many points were submitted.
+func (c *Client) SubmitMetricDataPoints(pageID string, metricID string, points []MetricDataPoint) error {
+	if pageID == "" || metricID == "" {
+		return errors.New("page ID and metric ID are required")
+	}
+	if err := validateMetricDataPoints(points, time.Now()); err != nil {
+		return fmt.Errorf("invalid metric data points: %w", err)
+	}
+
+	endpoint := fmt.Sprintf("/v1/%s/metrics/%s/data", pageID, metricID)
+
+	for start := 0; start < len(points); start += metricDataPointBatchSize {
+		end := min(start+metricDataPointBatchSize, len(points))
+		batch := struct {
+			Data []MetricDataPoint `json:"data"`
+		}{points[start:end]}
+
+		err := retryRateLimited(func() error {
+			_, err := c.doRequest("POST", endpoint, batch)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("error submitting metric data points (%d of %d submitted): %w", start, len(points), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package instatus
+
+import (
+	"encoding/json"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalizedText_UnmarshalJSON(t *testing.T) {
+	cases := map[string]struct {
+		input string
+		want  LocalizedText
+	}{
+		"plain string": {
+			input: `"Status"`,
+			want:  LocalizedText{Default: "Status"},
+		},
+		"localized object": {
+			input: `{"default": "Status", "en": "Status", "fr": "Statut"}`,
+			want: LocalizedText{
+				Default:      "Status",
+				Translations: map[string]string{"en": "Status", "fr": "Statut"},
+			},
+		},
+		"null": {
+			input: `null`,
+			want:  LocalizedText{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got LocalizedText
+			if err := json.Unmarshal([]byte(tc.input), &got); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %#v, want %#v", got, tc.want)
+			}
+		})
+	}
+}
+
+// newTestClient returns a client sending requests to handler
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	backoff := retryBackoff
+	retryBackoff = time.Millisecond
+	t.Cleanup(func() { retryBackoff = backoff })
+
+	client := NewClient("test-key")
+	client.baseURL = server.URL
+	return client
+}
+
+func testMetricDataPoints(n int) []MetricDataPoint {
+	start := time.Now().Add(-time.Hour)
+	points := make([]MetricDataPoint, n)
+	for i := range points {
+		points[i] = MetricDataPoint{Timestamp: start.Add(time.Duration(i) * time.Second), Value: float64(i)}
+	}
+	return points
+}
+
+func TestClient_SubmitMetricDataPoints_batches(t *testing.T) {
+	var batchSizes []int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/v1/page-id/metrics/metric-id/data" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var body struct {
+			Data []struct {
+				Timestamp int64   `json:"timestamp"`
+				Value     float64 `json:"value"`
+			} `json:"data"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("error decoding body: %s", err)
+		}
+		batchSizes = append(batchSizes, len(body.Data))
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	if err := client.SubmitMetricDataPoints("page-id", "metric-id", testMetricDataPoints(250)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(batchSizes, []int{100, 100, 50}) {
+		t.Fatalf("got batches of %v, want [100 100 50]", batchSizes)
+	}
+}
+
+func TestClient_SubmitMetricDataPoints_retries(t *testing.T) {
+	var attempts int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	if err := client.SubmitMetricDataPoint("page-id", "metric-id", testMetricDataPoints(1)[0]); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("got %d attempts, want 3", attempts)
+	}
+}
+
+func TestClient_SubmitMetricDataPoints_doesNotRetryServerError(t *testing.T) {
+	var attempts int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	if err := client.SubmitMetricDataPoint("page-id", "metric-id", testMetricDataPoints(1)[0]); err == nil {
+		t.Fatal("expected an error")
+	}
+	if attempts != 1 {
+		t.Fatalf("got %d attempts, want 1", attempts)
+	}
+}
+
+func TestClient_SubmitMetricDataPoints_stopsOnClientError(t *testing.T) {
+	var requests int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	err := client.SubmitMetricDataPoints("page-id", "metric-id", testMetricDataPoints(250))
+	if err == nil || !strings.Contains(err.Error(), "100 of 250 submitted") {
+		t.Fatalf("got error %v, want it to report 100 of 250 submitted", err)
+	}
+	if requests != 2 {
+		t.Fatalf("got %d requests, want 2", requests)
+	}
+}
+
+func TestClient_SubmitMetricDataPoints_validates(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+	})
+
+	points := testMetricDataPoints(3)
+	points[0].Timestamp = time.Time{}
+	points[1].Value = math.NaN()
+	points[2].Timestamp = time.Now().Add(time.Hour)
+
+	err := client.SubmitMetricDataPoints("page-id", "metric-id", points)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, want := range []string{"data point 0: timestamp is required", "data point 1: value must be a finite number", "data point 2: timestamp"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("got error %q, want it to contain %q", err, want)
+		}
+	}
+}
+
+func TestMonitor_MarshalJSON_zeroGracePeriod(t *testing.T) {
+	body, err := json.Marshal(Monitor{Name: "Nightly backup", Type: "HEARTBEAT", Interval: 86400})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !strings.Contains(string(body), `"gracePeriod":0`) {
+		t.Fatalf("got %s, want it to send a zero gracePeriod", body)
+	}
+}
@@ -20,8 +20,26 @@ func Provider() *schema.Provider {
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"instatus_component": resourceComponent(),
-			"instatus_page":      resourcePage(),
+			"instatus_automation":                 resourceAutomation(),
+			"instatus_component":                  resourceComponent(),
+			"instatus_custom_domain":              resourceCustomDomain(),
+			"instatus_custom_domain_verification": resourceCustomDomainVerification(),
+			"instatus_heartbeat":                  resourceHeartbeat(),
+			"instatus_incident":                   resourceIncident(),
+			"instatus_incident_template":          resourceIncidentTemplate(),
+			"instatus_incident_update":            resourceIncidentTimelineUpdate(),
+			"instatus_maintenance":                resourceMaintenance(),
+			"instatus_maintenance_template":       resourceMaintenanceTemplate(),
+			"instatus_maintenance_update":         resourceMaintenanceTimelineUpdate(),
+			"instatus_metric":                     resourceMetric(),
+			"instatus_monitor":                    resourceMonitor(),
+			"instatus_notification_integration":   resourceNotificationIntegration(),
+			"instatus_page":                       resourcePage(),
+			"instatus_recurring_maintenance":      resourceRecurringMaintenance(),
+			"instatus_subscriber":                 resourceSubscriber(),
+			"instatus_subscribers":                resourceSubscribers(),
+			"instatus_team_member":                resourceTeamMember(),
+			"instatus_workspace":                  resourceWorkspace(),
 		},
 		DataSourcesMap:       map[string]*schema.Resource{},
 		ConfigureContextFunc: providerConfigure,
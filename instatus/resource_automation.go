@@ -0,0 +1,249 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	automationSources   = []string{"PAGERDUTY", "OPSGENIE", "DATADOG", "GRAFANA", "EMAIL"}
+	automationOperators = []string{"EQUALS", "CONTAINS", "MATCHES"}
+)
+
+func resourceAutomation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAutomationCreate,
+		ReadContext:   resourceAutomationRead,
+		UpdateContext: resourceAutomationUpdate,
+		DeleteContext: resourceAutomationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAutomationImport,
+		},
+		CustomizeDiff: resourceAutomationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the automation",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(automationSources, false),
+				Description:  "The tool alerts come from (PAGERDUTY, OPSGENIE, DATADOG, GRAFANA, EMAIL)",
+			},
+			"rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Conditions incoming alerts must all match. Every alert from the source matches when empty",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The alert field to match (e.g. service, title, tag, subject)",
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "EQUALS",
+							ValidateFunc: validation.StringInSlice(automationOperators, false),
+							Description:  "How the field is compared (EQUALS, CONTAINS, MATCHES)",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value, or regular expression for MATCHES, to compare the field with",
+						},
+					},
+				},
+			},
+			"component_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The components whose status the automation changes",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(componentStatuses, false),
+				Description:  "The status set on the components while a matching alert is open",
+			},
+			"resolved_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "OPERATIONAL",
+				ValidateFunc: validation.StringInSlice(componentStatuses, false),
+				Description:  "The status set on the components once the alert resolves",
+			},
+			"trigger_email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The address alerts are emailed to, such as the unique_email of a component. Required for EMAIL automations",
+			},
+			"webhook_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL to configure in the alerting tool as the destination of its alerts",
+			},
+		},
+	}
+}
+
+func resourceAutomationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("trigger_email") {
+		return nil
+	}
+
+	isEmail := d.Get("source").(string) == "EMAIL"
+	hasEmail := d.Get("trigger_email").(string) != ""
+	switch {
+	case isEmail && !hasEmail:
+		return fmt.Errorf("trigger_email is required for EMAIL automations")
+	case !isEmail && hasEmail:
+		return fmt.Errorf("trigger_email can only be set for EMAIL automations")
+	}
+
+	return nil
+}
+
+// resourceAutomationImport accepts an ID of the form <page_id>/<automation_id>
+func resourceAutomationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, automationID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(automationID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAutomation(d *schema.ResourceData) *Automation {
+	automation := &Automation{
+		Name:           d.Get("name").(string),
+		Source:         d.Get("source").(string),
+		Rules:          []AutomationRule{},
+		Components:     expandStringList(d.Get("component_ids").(*schema.Set).List()),
+		Status:         d.Get("status").(string),
+		ResolvedStatus: d.Get("resolved_status").(string),
+		Email:          d.Get("trigger_email").(string),
+	}
+
+	for _, item := range d.Get("rule").([]interface{}) {
+		m := item.(map[string]interface{})
+		automation.Rules = append(automation.Rules, AutomationRule{
+			Field:    m["field"].(string),
+			Operator: m["operator"].(string),
+			Value:    m["value"].(string),
+		})
+	}
+
+	return automation
+}
+
+func resourceAutomationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	created, err := client.CreateAutomation(d.Get("page_id").(string), expandAutomation(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating automation: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceAutomationRead(ctx, d, meta)
+}
+
+func resourceAutomationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	automation, err := client.GetAutomation(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading automation: %w", err))
+	}
+
+	rules := make([]interface{}, 0, len(automation.Rules))
+	for _, rule := range automation.Rules {
+		rules = append(rules, map[string]interface{}{
+			"field":    rule.Field,
+			"operator": rule.Operator,
+			"value":    rule.Value,
+		})
+	}
+
+	if err := d.Set("name", automation.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("source", automation.Source); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("rule", rules); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("component_ids", automation.Components); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", automation.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("resolved_status", automation.ResolvedStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("trigger_email", automation.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("webhook_url", automation.WebhookURL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceAutomationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	_, err := client.UpdateAutomation(d.Get("page_id").(string), d.Id(), expandAutomation(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating automation: %w", err))
+	}
+
+	return resourceAutomationRead(ctx, d, meta)
+}
+
+func resourceAutomationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteAutomation(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting automation: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
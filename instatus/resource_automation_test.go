@@ -0,0 +1,69 @@
+package instatus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceAutomation_email(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceAutomationConfig("MAJOROUTAGE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_automation.test", "source", "EMAIL"),
+					resource.TestCheckResourceAttr("instatus_automation.test", "status", "MAJOROUTAGE"),
+					resource.TestCheckResourceAttr("instatus_automation.test", "rule.#", "1"),
+					resource.TestCheckResourceAttrPair("instatus_automation.test", "trigger_email", "instatus_component.test", "unique_email"),
+				),
+			},
+			{
+				Config: testAccResourceAutomationConfig("PARTIALOUTAGE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_automation.test", "status", "PARTIALOUTAGE"),
+				),
+			},
+			{
+				ResourceName:      "instatus_automation.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccPageScopedImportID("instatus_automation.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceAutomationConfig(status string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-automations"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "API"
+}
+
+resource "instatus_automation" "test" {
+  page_id       = instatus_page.test.id
+  name          = "API alerts"
+  source        = "EMAIL"
+  trigger_email = instatus_component.test.unique_email
+  component_ids = [instatus_component.test.id]
+  status        = %q
+
+  rule {
+    field    = "subject"
+    operator = "CONTAINS"
+    value    = "[api]"
+  }
+}
+`, status)
+}
@@ -3,8 +3,10 @@ package instatus
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -15,10 +17,20 @@ func resourceComponent() *schema.Resource {
 		UpdateContext: resourceComponentUpdate,
 		DeleteContext: resourceComponentDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceComponentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page the component belongs to",
+			},
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -80,6 +92,21 @@ func resourceComponent() *schema.Resource {
 	}
 }
 
+// resourceComponentImport accepts an ID of the form <page_id>/<component_id>
+func resourceComponentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, componentID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(componentID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceComponentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 
@@ -103,13 +130,34 @@ func resourceComponentCreate(ctx context.Context, d *schema.ResourceData, meta i
 		component.GroupID = groupID.(string)
 	}
 
-	created, err := client.CreateComponent(component)
+	// A page that was just created may not accept components yet
+	start := time.Now()
+	var created *Component
+	err := retry.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var err error
+		created, err = client.CreateComponent(component)
+		if err != nil {
+			if IsNotFound(err) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+		return nil
+	})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating component: %w", err))
 	}
 
 	d.SetId(created.ID)
 
+	err = waitUntilReadable(ctx, d.Timeout(schema.TimeoutCreate)-time.Since(start), func() error {
+		_, err := client.GetComponent(created.ID, component.PageId)
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for component to be provisioned: %w", err))
+	}
+
 	return resourceComponentRead(ctx, d, meta)
 }
 
@@ -119,6 +167,10 @@ func resourceComponentRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	component, err := client.GetComponent(d.Id(), d.Get("page_id").(string))
 	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
 		return diag.FromErr(fmt.Errorf("error reading component: %w", err))
 	}
 
@@ -161,6 +213,7 @@ func resourceComponentUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	component := &Component{
 		Name:        d.Get("name").(string),
+		PageId:      d.Get("page_id").(string),
 		Description: d.Get("description").(string),
 		Status:      d.Get("status").(string),
 		ShowUptime:  d.Get("show_uptime").(bool),
@@ -0,0 +1,47 @@
+package instatus
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceComponent_withNewPage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceComponentConfig_withNewPage(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("instatus_component.test", "page_id", "instatus_page.test", "id"),
+					resource.TestCheckResourceAttr("instatus_component.test", "name", "API"),
+					resource.TestCheckResourceAttr("instatus_component.test", "status", "OPERATIONAL"),
+					resource.TestCheckResourceAttrSet("instatus_component.test", "unique_email"),
+				),
+			},
+			{
+				ResourceName:      "instatus_component.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccPageScopedImportID("instatus_component.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceComponentConfig_withNewPage() string {
+	return `
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-components"
+}
+
+resource "instatus_component" "test" {
+  page_id     = instatus_page.test.id
+  name        = "API"
+  description = "Public API"
+}
+`
+}
@@ -0,0 +1,182 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceCustomDomain() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomDomainCreate,
+		ReadContext:   resourceCustomDomainRead,
+		DeleteContext: resourceCustomDomainDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The custom domain for the status page (e.g. status.example.com)",
+			},
+			"cname_record_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the CNAME record to create",
+			},
+			"cname_record_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value of the CNAME record to create",
+			},
+			"txt_record_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the TXT record used for domain verification",
+			},
+			"txt_record_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value of the TXT record used for domain verification",
+			},
+			"dns_records": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "All DNS records required by Instatus",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The record type (CNAME, TXT)",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The record name",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The record value",
+						},
+					},
+				},
+			},
+			"verification_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The domain verification status (PENDING, VERIFIED, FAILED)",
+			},
+			"ssl_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SSL certificate status (PENDING, ISSUED, FAILED)",
+			},
+		},
+	}
+}
+
+func resourceCustomDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	pageID := d.Get("page_id").(string)
+
+	domain := &CustomDomain{
+		Domain: d.Get("domain").(string),
+	}
+
+	if _, err := client.CreateCustomDomain(pageID, domain); err != nil {
+		return diag.FromErr(fmt.Errorf("error creating custom domain: %w", err))
+	}
+
+	// A page has at most one custom domain, so the page ID identifies it
+	d.SetId(pageID)
+
+	return resourceCustomDomainRead(ctx, d, meta)
+}
+
+func resourceCustomDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	domain, err := client.GetCustomDomain(d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading custom domain: %w", err))
+	}
+
+	if err := d.Set("page_id", d.Id()); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("domain", domain.Domain); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verification_status", domain.VerificationStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ssl_status", domain.SSLStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	records := make([]interface{}, 0, len(domain.DNSRecords))
+	var cname, txt DNSRecord
+	for _, record := range domain.DNSRecords {
+		records = append(records, map[string]interface{}{
+			"type":  record.Type,
+			"name":  record.Name,
+			"value": record.Value,
+		})
+		switch record.Type {
+		case "CNAME":
+			cname = record
+		case "TXT":
+			txt = record
+		}
+	}
+
+	if err := d.Set("dns_records", records); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cname_record_name", cname.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cname_record_value", cname.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("txt_record_name", txt.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("txt_record_value", txt.Value); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceCustomDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteCustomDomain(d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting custom domain: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,51 @@
+package instatus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceCustomDomain_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomDomainConfig("test-page-custom-domain", "status.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_custom_domain.test", "domain", "status.example.com"),
+					resource.TestCheckResourceAttrSet("instatus_custom_domain.test", "cname_record_name"),
+					resource.TestCheckResourceAttrSet("instatus_custom_domain.test", "cname_record_value"),
+					resource.TestCheckResourceAttrSet("instatus_custom_domain.test", "txt_record_name"),
+					resource.TestCheckResourceAttrSet("instatus_custom_domain.test", "txt_record_value"),
+				),
+			},
+			{
+				ResourceName:      "instatus_custom_domain.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceCustomDomainConfig(slug, domain string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = %q
+
+  lifecycle {
+    ignore_changes = [custom_domain]
+  }
+}
+
+resource "instatus_custom_domain" "test" {
+  page_id = instatus_page.test.id
+  domain  = %q
+}
+`, slug, domain)
+}
@@ -0,0 +1,153 @@
+package instatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// errCustomDomainFailed is returned by the refresh function when Instatus
+// gives up on verifying the domain or issuing its certificate
+var errCustomDomainFailed = errors.New("custom domain verification failed")
+
+func resourceCustomDomainVerification() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceCustomDomainVerificationCreate,
+		ReadContext:   resourceCustomDomainVerificationRead,
+		DeleteContext: resourceCustomDomainVerificationDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page whose custom domain should be verified",
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The verified custom domain",
+			},
+			"verification_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The domain verification status (PENDING, VERIFIED, FAILED)",
+			},
+			"ssl_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The SSL certificate status (PENDING, ISSUED, FAILED)",
+			},
+		},
+	}
+}
+
+func resourceCustomDomainVerificationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	pageID := d.Get("page_id").(string)
+
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{"PENDING"},
+		Target:     []string{"ACTIVE"},
+		Refresh:    customDomainStateRefreshFunc(client, pageID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 15 * time.Second,
+	}
+
+	result, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		if errors.Is(err, errCustomDomainFailed) {
+			detail := "Instatus could not verify the custom domain or issue its certificate. Check that the DNS records exported by instatus_custom_domain exist."
+			if domain, ok := result.(*CustomDomain); ok && domain.VerificationError != "" {
+				detail = domain.VerificationError
+			}
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "Custom domain verification failed",
+					Detail:   detail,
+				},
+			}
+		}
+		return diag.FromErr(fmt.Errorf("error waiting for custom domain verification: %w", err))
+	}
+
+	d.SetId(pageID)
+
+	return resourceCustomDomainVerificationRead(ctx, d, meta)
+}
+
+// customDomainStateRefreshFunc reports ACTIVE once the domain is verified and
+// its certificate issued, and PENDING until then
+func customDomainStateRefreshFunc(client *Client, pageID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		domain, err := client.GetCustomDomain(pageID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if domain.VerificationStatus == "FAILED" || domain.SSLStatus == "FAILED" {
+			return domain, "FAILED", errCustomDomainFailed
+		}
+		if domain.VerificationStatus == "VERIFIED" && domain.SSLStatus == "ISSUED" {
+			return domain, "ACTIVE", nil
+		}
+
+		return domain, "PENDING", nil
+	}
+}
+
+func resourceCustomDomainVerificationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	domain, err := client.GetCustomDomain(d.Id())
+	if err != nil {
+		if IsNotFound(err) {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading custom domain: %w", err))
+	}
+
+	// A domain can lose its verification or certificate later, for example
+	// when its DNS records change. Drop it from state so the next apply
+	// waits for it to be verified again.
+	if domain.VerificationStatus != "VERIFIED" || domain.SSLStatus != "ISSUED" {
+		d.SetId("")
+		return append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Custom domain is no longer verified",
+			Detail:   fmt.Sprintf("The custom domain %q has verification status %s and SSL status %s. It will be verified again on the next apply.", domain.Domain, domain.VerificationStatus, domain.SSLStatus),
+		})
+	}
+
+	if err := d.Set("domain", domain.Domain); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verification_status", domain.VerificationStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("ssl_status", domain.SSLStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceCustomDomainVerificationDelete only removes the resource from state,
+// the custom domain itself is owned by instatus_custom_domain
+func resourceCustomDomainVerificationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,93 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceCustomDomainVerificationRead(t *testing.T) {
+	cases := map[string]struct {
+		verificationStatus string
+		sslStatus          string
+		wantVerified       bool
+	}{
+		"still verified":          {verificationStatus: "VERIFIED", sslStatus: "ISSUED", wantVerified: true},
+		"verification failed":     {verificationStatus: "FAILED", sslStatus: "ISSUED"},
+		"verification pending":    {verificationStatus: "PENDING", sslStatus: "PENDING"},
+		"certificate not renewed": {verificationStatus: "VERIFIED", sslStatus: "FAILED"},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != "/v2/page-id/custom-domain" {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprintf(w, `{"domain": "status.example.com", "verificationStatus": %q, "sslStatus": %q}`, tc.verificationStatus, tc.sslStatus)
+			})
+
+			d := schema.TestResourceDataRaw(t, resourceCustomDomainVerification().Schema, map[string]interface{}{
+				"page_id": "page-id",
+			})
+			d.SetId("page-id")
+
+			diags := resourceCustomDomainVerificationRead(context.Background(), d, client)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+
+			if tc.wantVerified {
+				if d.Id() != "page-id" || len(diags) != 0 {
+					t.Fatalf("got ID %q and diagnostics %#v, want the verification kept", d.Id(), diags)
+				}
+				return
+			}
+			if d.Id() != "" || len(diags) != 1 {
+				t.Fatalf("got ID %q and diagnostics %#v, want the verification removed with a warning", d.Id(), diags)
+			}
+		})
+	}
+}
+
+// TestAccResourceCustomDomainVerification_basic needs a domain whose DNS
+// can point at Instatus, so it only runs when
+// INSTATUS_TEST_CUSTOM_DOMAIN names one. The CNAME and TXT records exported
+// by the first step must be in place when the second step runs.
+func TestAccResourceCustomDomainVerification_basic(t *testing.T) {
+	domain := os.Getenv("INSTATUS_TEST_CUSTOM_DOMAIN")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			if domain == "" {
+				t.Skip("INSTATUS_TEST_CUSTOM_DOMAIN must be set to test custom domain verification")
+			}
+		},
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceCustomDomainConfig("test-page-domain-verification", domain),
+			},
+			{
+				Config: testAccResourceCustomDomainConfig("test-page-domain-verification", domain) + `
+resource "instatus_custom_domain_verification" "test" {
+  page_id = instatus_custom_domain.test.page_id
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_custom_domain_verification.test", "domain", domain),
+					resource.TestCheckResourceAttr("instatus_custom_domain_verification.test", "verification_status", "VERIFIED"),
+					resource.TestCheckResourceAttr("instatus_custom_domain_verification.test", "ssl_status", "ISSUED"),
+				),
+			},
+		},
+	})
+}
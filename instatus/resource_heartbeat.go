@@ -0,0 +1,173 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// heartbeatMonitorType is the monitor type Instatus uses for heartbeats
+const heartbeatMonitorType = "HEARTBEAT"
+
+func resourceHeartbeat() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceHeartbeatCreate,
+		ReadContext:   resourceHeartbeatRead,
+		UpdateContext: resourceHeartbeatUpdate,
+		DeleteContext: resourceHeartbeatDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceHeartbeatImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the heartbeat",
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(60, 31*24*60*60),
+				Description:  "Seconds expected between check-ins",
+			},
+			"grace_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(0, 24*60*60),
+				Description:  "Seconds a check-in may be late before the heartbeat fails",
+			},
+			"component_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The component whose status follows the heartbeat",
+			},
+			"failure_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "DEGRADEDPERFORMANCE",
+				ValidateFunc: validation.StringInSlice(componentStatuses, false),
+				Description:  "The status set on the component while check-ins are missing",
+			},
+			"check_in_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The URL jobs request to check in",
+			},
+		},
+	}
+}
+
+// resourceHeartbeatImport accepts an ID of the form <page_id>/<heartbeat_id>
+func resourceHeartbeatImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, heartbeatID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(heartbeatID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandHeartbeat(d *schema.ResourceData) *Monitor {
+	return &Monitor{
+		Name:          d.Get("name").(string),
+		Type:          heartbeatMonitorType,
+		Interval:      d.Get("period").(int),
+		GracePeriod:   d.Get("grace_period").(int),
+		ComponentID:   d.Get("component_id").(string),
+		FailureStatus: d.Get("failure_status").(string),
+	}
+}
+
+func resourceHeartbeatCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	created, err := client.CreateMonitor(d.Get("page_id").(string), expandHeartbeat(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating heartbeat: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceHeartbeatRead(ctx, d, meta)
+}
+
+func resourceHeartbeatRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	heartbeat, err := client.GetMonitor(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading heartbeat: %w", err))
+	}
+
+	if heartbeat.Type != heartbeatMonitorType {
+		return diag.Errorf("monitor %s is a %s monitor, not a heartbeat; manage it with instatus_monitor", d.Id(), heartbeat.Type)
+	}
+
+	if err := d.Set("name", heartbeat.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("period", heartbeat.Interval); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("grace_period", heartbeat.GracePeriod); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("component_id", heartbeat.ComponentID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("failure_status", heartbeat.FailureStatus); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("check_in_url", heartbeat.CheckInURL); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceHeartbeatUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	_, err := client.UpdateMonitor(d.Get("page_id").(string), d.Id(), expandHeartbeat(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating heartbeat: %w", err))
+	}
+
+	return resourceHeartbeatRead(ctx, d, meta)
+}
+
+func resourceHeartbeatDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteMonitor(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting heartbeat: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
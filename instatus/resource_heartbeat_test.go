@@ -0,0 +1,61 @@
+package instatus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceHeartbeat_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceHeartbeatConfig(3600),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_heartbeat.test", "period", "3600"),
+					resource.TestCheckResourceAttr("instatus_heartbeat.test", "failure_status", "DEGRADEDPERFORMANCE"),
+					resource.TestCheckResourceAttrSet("instatus_heartbeat.test", "check_in_url"),
+				),
+			},
+			{
+				Config: testAccResourceHeartbeatConfig(86400),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_heartbeat.test", "period", "86400"),
+				),
+			},
+			{
+				ResourceName:      "instatus_heartbeat.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccPageScopedImportID("instatus_heartbeat.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceHeartbeatConfig(period int) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-heartbeats"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "Nightly export"
+}
+
+resource "instatus_heartbeat" "test" {
+  page_id      = instatus_page.test.id
+  name         = "Nightly export"
+  period       = %d
+  grace_period = 600
+  component_id = instatus_component.test.id
+}
+`, period)
+}
@@ -0,0 +1,320 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var incidentStatuses = []string{"INVESTIGATING", "IDENTIFIED", "MONITORING", "RESOLVED"}
+
+var componentStatuses = []string{"OPERATIONAL", "UNDERMAINTENANCE", "DEGRADEDPERFORMANCE", "PARTIALOUTAGE", "MAJOROUTAGE"}
+
+func resourceIncident() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIncidentCreate,
+		ReadContext:   resourceIncidentRead,
+		UpdateContext: resourceIncidentUpdate,
+		DeleteContext: resourceIncidentDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIncidentImport,
+		},
+		CustomizeDiff: customizeDiffFromTemplate(
+			incidentTemplateType,
+			[]string{"name", "message", "status", "components"},
+			[]string{"name", "message", "status"},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"template_id": templateIDSchema(incidentTemplateType),
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the incident. Required unless set by the template",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The initial message of the incident. Required unless set by the template",
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(incidentStatuses, false),
+				Description:  "The status of the incident (INVESTIGATING, IDENTIFIED, MONITORING, RESOLVED). Required unless set by the template",
+			},
+			"components": templatedSchema(affectedComponentsSchema("The components affected by the incident", false)),
+			"started": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+				Description:      "When the incident started, in RFC3339 format. Defaults to the creation time",
+			},
+			"notify_subscribers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to notify subscribers when the incident is created or changed",
+			},
+			"resolved": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the incident was resolved",
+			},
+			"previous_component_statuses": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "The status of each affected component before the incident, restored when the incident is resolved",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// affectedComponentsSchema describes the components affected by an incident
+// or maintenance along with the status each should have
+func affectedComponentsSchema(description string, forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		ForceNew:    forceNew,
+		Description: description,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"component_id": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "The ID of the affected component",
+				},
+				"status": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(componentStatuses, false),
+					Description:  "The status of the component (OPERATIONAL, UNDERMAINTENANCE, DEGRADEDPERFORMANCE, PARTIALOUTAGE, MAJOROUTAGE)",
+				},
+			},
+		},
+	}
+}
+
+// resourceIncidentImport accepts an ID of the form <page_id>/<incident_id>
+func resourceIncidentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, incidentID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(incidentID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("notify_subscribers", true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandAffectedComponents(v []interface{}) ([]string, []AffectedComponent) {
+	components := make([]string, 0, len(v))
+	statuses := make([]AffectedComponent, 0, len(v))
+	for _, item := range v {
+		m := item.(map[string]interface{})
+		components = append(components, m["component_id"].(string))
+		statuses = append(statuses, AffectedComponent{
+			ID:     m["component_id"].(string),
+			Status: m["status"].(string),
+		})
+	}
+	return components, statuses
+}
+
+func resourceIncidentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	pageID := d.Get("page_id").(string)
+
+	components, statuses := expandAffectedComponents(d.Get("components").(*schema.Set).List())
+
+	incident := &Incident{
+		Name:       d.Get("name").(string),
+		Message:    d.Get("message").(string),
+		Status:     d.Get("status").(string),
+		Started:    d.Get("started").(string),
+		Notify:     d.Get("notify_subscribers").(bool),
+		Components: components,
+		Statuses:   statuses,
+	}
+
+	// Remember how the components looked so they can be restored on resolution
+	previous := make(map[string]interface{}, len(components))
+	for _, componentID := range components {
+		component, err := client.GetComponent(componentID, pageID)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error reading affected component %s: %w", componentID, err))
+		}
+		previous[componentID] = component.Status
+	}
+
+	// An incident created as resolved is over, so leave the components as
+	// they were rather than putting them in the configured statuses
+	if incident.Status == "RESOLVED" {
+		restoreComponentStatuses(incident.Statuses, previous)
+	}
+
+	created, err := client.CreateIncident(pageID, incident)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating incident: %w", err))
+	}
+
+	d.SetId(created.ID)
+	if err := d.Set("previous_component_statuses", previous); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIncidentRead(ctx, d, meta)
+}
+
+func resourceIncidentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	incident, err := client.GetIncident(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading incident: %w", err))
+	}
+
+	if err := d.Set("name", incident.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("message", incident.Message); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", incident.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("started", incident.Started); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("resolved", incident.Resolved); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Once resolved the components are back to their previous status, so keep
+	// the statuses they had during the incident from state
+	configured := make(map[string]string)
+	for _, item := range d.Get("components").(*schema.Set).List() {
+		m := item.(map[string]interface{})
+		configured[m["component_id"].(string)] = m["status"].(string)
+	}
+
+	components := make([]interface{}, 0, len(incident.Statuses))
+	for _, status := range incident.Statuses {
+		componentStatus := status.Status
+		if configuredStatus, ok := configured[status.ID]; ok && incident.Status == "RESOLVED" {
+			componentStatus = configuredStatus
+		}
+		components = append(components, map[string]interface{}{
+			"component_id": status.ID,
+			"status":       componentStatus,
+		})
+	}
+	if err := d.Set("components", components); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceIncidentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	pageID := d.Get("page_id").(string)
+
+	components, statuses := expandAffectedComponents(d.Get("components").(*schema.Set).List())
+
+	incident := &Incident{
+		Name:       d.Get("name").(string),
+		Message:    d.Get("message").(string),
+		Status:     d.Get("status").(string),
+		Started:    d.Get("started").(string),
+		Notify:     d.Get("notify_subscribers").(bool),
+		Components: components,
+		Statuses:   statuses,
+	}
+
+	previous := d.Get("previous_component_statuses").(map[string]interface{})
+
+	// Restore the affected components when the incident is resolved. State
+	// keeps the statuses they had during the incident, so this applies to
+	// every change of a resolved incident, not only the resolution.
+	if incident.Status == "RESOLVED" {
+		restoreComponentStatuses(incident.Statuses, previous)
+	}
+
+	// Capture the status of components newly added to an ongoing incident
+	if incident.Status != "RESOLVED" {
+		for _, componentID := range components {
+			if _, ok := previous[componentID]; ok {
+				continue
+			}
+			component, err := client.GetComponent(componentID, pageID)
+			if err != nil {
+				return diag.FromErr(fmt.Errorf("error reading affected component %s: %w", componentID, err))
+			}
+			previous[componentID] = component.Status
+		}
+	}
+
+	_, err := client.UpdateIncident(pageID, d.Id(), incident)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating incident: %w", err))
+	}
+
+	if err := d.Set("previous_component_statuses", previous); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIncidentRead(ctx, d, meta)
+}
+
+// restoreComponentStatuses sets each status back to the one the component had
+// before the incident, or OPERATIONAL when it is unknown
+func restoreComponentStatuses(statuses []AffectedComponent, previous map[string]interface{}) {
+	for i := range statuses {
+		statuses[i].Status = "OPERATIONAL"
+		if status, ok := previous[statuses[i].ID].(string); ok && status != "" {
+			statuses[i].Status = status
+		}
+	}
+}
+
+func resourceIncidentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteIncident(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting incident: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,182 @@
+package instatus
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceIncidentCreate_resolved(t *testing.T) {
+	var sent Incident
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/v2/page-id/components/component-id":
+			fmt.Fprint(w, `{"id": "component-id", "name": "API", "status": "DEGRADEDPERFORMANCE"}`)
+		case r.Method == "POST" && r.URL.Path == "/v1/page-id/incidents":
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("error decoding request: %s", err)
+			}
+			fmt.Fprint(w, `{"id": "incident-id"}`)
+		case r.Method == "GET" && r.URL.Path == "/v1/page-id/incidents/incident-id":
+			fmt.Fprint(w, `{
+				"id": "incident-id",
+				"name": "API unavailable",
+				"status": "RESOLVED",
+				"components": [{"id": "component-id", "name": "API", "status": "DEGRADEDPERFORMANCE"}]
+			}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceIncident().Schema, map[string]interface{}{
+		"page_id": "page-id",
+		"name":    "API unavailable",
+		"message": "The API was unavailable for five minutes.",
+		"status":  "RESOLVED",
+		"components": []interface{}{
+			map[string]interface{}{"component_id": "component-id", "status": "MAJOROUTAGE"},
+		},
+	})
+
+	if diags := resourceIncidentCreate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	want := []AffectedComponent{{ID: "component-id", Status: "DEGRADEDPERFORMANCE"}}
+	if !reflect.DeepEqual(sent.Statuses, want) {
+		t.Fatalf("got statuses %#v, want %#v", sent.Statuses, want)
+	}
+}
+
+func TestResourceIncidentUpdate_resolved(t *testing.T) {
+	var sent Incident
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/v1/page-id/incidents/incident-id":
+			if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+				t.Errorf("error decoding request: %s", err)
+			}
+			fmt.Fprint(w, `{"id": "incident-id"}`)
+		case r.Method == "GET" && r.URL.Path == "/v1/page-id/incidents/incident-id":
+			fmt.Fprint(w, `{
+				"id": "incident-id",
+				"name": "API unavailable",
+				"status": "RESOLVED",
+				"components": [{"id": "component-id", "name": "API", "status": "DEGRADEDPERFORMANCE"}]
+			}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+
+	r := resourceIncident()
+	state := &terraform.InstanceState{
+		ID: "incident-id",
+		Attributes: map[string]string{
+			"id":                            "incident-id",
+			"page_id":                       "page-id",
+			"name":                          "API unavailable",
+			"message":                       "The API was unavailable.",
+			"status":                        "RESOLVED",
+			"notify_subscribers":            "true",
+			"components.#":                  "1",
+			"components.0.component_id":     "component-id",
+			"components.0.status":           "MAJOROUTAGE",
+			"previous_component_statuses.%": "1",
+			"previous_component_statuses.component-id": "DEGRADEDPERFORMANCE",
+		},
+	}
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"message": {Old: "The API was unavailable.", New: "The API was unavailable for five minutes."},
+		},
+	}
+
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceIncidentUpdate(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	want := []AffectedComponent{{ID: "component-id", Status: "DEGRADEDPERFORMANCE"}}
+	if !reflect.DeepEqual(sent.Statuses, want) {
+		t.Fatalf("got statuses %#v, want %#v", sent.Statuses, want)
+	}
+}
+
+func TestAccResourceIncident_resolve(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIncidentConfig("INVESTIGATING"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_incident.test", "status", "INVESTIGATING"),
+					resource.TestCheckResourceAttr("instatus_incident.test", "components.#", "1"),
+					resource.TestCheckResourceAttr("instatus_incident.test", "previous_component_statuses.%", "1"),
+					resource.TestCheckResourceAttrSet("instatus_incident.test", "started"),
+				),
+			},
+			{
+				Config: testAccResourceIncidentConfig("RESOLVED"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_incident.test", "status", "RESOLVED"),
+					resource.TestCheckResourceAttrSet("instatus_incident.test", "resolved"),
+				),
+			},
+			{
+				ResourceName:            "instatus_incident.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccPageScopedImportID("instatus_incident.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"components", "previous_component_statuses"},
+			},
+		},
+	})
+}
+
+func testAccResourceIncidentConfig(status string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-incidents"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "API"
+
+  lifecycle {
+    ignore_changes = [status]
+  }
+}
+
+resource "instatus_incident" "test" {
+  page_id = instatus_page.test.id
+  name    = "API unavailable"
+  message = "We are investigating errors on the public API."
+  status  = %q
+
+  components {
+    component_id = instatus_component.test.id
+    status       = "MAJOROUTAGE"
+  }
+}
+`, status)
+}
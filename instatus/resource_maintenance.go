@@ -0,0 +1,328 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var maintenanceStatuses = []string{"NOTSTARTEDYET", "INPROGRESS", "COMPLETED"}
+
+func resourceMaintenance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMaintenanceCreate,
+		ReadContext:   resourceMaintenanceRead,
+		UpdateContext: resourceMaintenanceUpdate,
+		DeleteContext: resourceMaintenanceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMaintenanceImport,
+		},
+		CustomizeDiff: customdiff.All(
+			customizeDiffFromTemplate(
+				maintenanceTemplateType,
+				[]string{"name", "message", "components"},
+				[]string{"name", "message"},
+			),
+			resourceMaintenanceCustomizeDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"template_id": templateIDSchema(maintenanceTemplateType),
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the maintenance. Required unless set by the template",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The message announcing the maintenance. Required unless set by the template",
+			},
+			"start": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+				Description:      "When the maintenance starts, in RFC3339 format with a timezone offset (e.g. 2025-03-11T02:00:00Z)",
+			},
+			"end": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+				Description:      "When the maintenance ends, in RFC3339 format with a timezone offset",
+			},
+			"components": templatedSchema(affectedComponentsSchema("The components affected by the maintenance along with their status during the window", false)),
+			"auto_start": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether Instatus starts the maintenance automatically at the start time",
+			},
+			"auto_end": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether Instatus completes the maintenance automatically at the end time",
+			},
+			"notify_subscribers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to notify subscribers when the maintenance is scheduled or changed",
+			},
+			"notify_before_minutes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Lead times, in minutes before the start, at which subscribers are reminded of the maintenance",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"name_translations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Localized names of the maintenance, keyed by language code",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"message_translations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Localized messages of the maintenance, keyed by language code",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the maintenance (NOTSTARTEDYET, INPROGRESS, COMPLETED)",
+			},
+		},
+	}
+}
+
+// resourceMaintenanceCustomizeDiff rejects windows that end before they
+// start, new or moved windows that already started, and ends moved into the
+// past. Changing only the end of a running window extends it.
+func resourceMaintenanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("start") || !d.NewValueKnown("end") {
+		return nil
+	}
+
+	checkStart := d.Id() == "" || maintenanceTimeChanged(d, "start")
+	checkEnd := maintenanceTimeChanged(d, "end")
+
+	return validateMaintenanceWindow(d.Get("start").(string), d.Get("end").(string), checkStart, checkEnd, time.Now())
+}
+
+// maintenanceTimeChanged compares the old and new value of key as instants,
+// since Instatus returns timestamps in UTC with milliseconds. Values that do
+// not parse count as changed, so that they are validated.
+func maintenanceTimeChanged(d *schema.ResourceDiff, key string) bool {
+	o, n := d.GetChange(key)
+	oldTime, err := time.Parse(time.RFC3339, o.(string))
+	if err != nil {
+		return true
+	}
+	newTime, err := time.Parse(time.RFC3339, n.(string))
+	if err != nil {
+		return true
+	}
+	return !oldTime.Equal(newTime)
+}
+
+func validateMaintenanceWindow(start, end string, checkStart, checkEnd bool, now time.Time) error {
+	startTime, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return fmt.Errorf("invalid start %q: %w", start, err)
+	}
+	endTime, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return fmt.Errorf("invalid end %q: %w", end, err)
+	}
+
+	if !endTime.After(startTime) {
+		return fmt.Errorf("end (%s) must be after start (%s)", end, start)
+	}
+	if checkStart && !startTime.After(now) {
+		return fmt.Errorf("start (%s) is in the past", start)
+	}
+	if checkEnd && !endTime.After(now) {
+		return fmt.Errorf("end (%s) is in the past", end)
+	}
+
+	return nil
+}
+
+// resourceMaintenanceImport accepts an ID of the form <page_id>/<maintenance_id>
+func resourceMaintenanceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, maintenanceID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(maintenanceID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("notify_subscribers", true); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandMaintenance(d *schema.ResourceData) *Maintenance {
+	components, statuses := expandAffectedComponents(d.Get("components").(*schema.Set).List())
+
+	maintenance := &Maintenance{
+		Name:         d.Get("name").(string),
+		Message:      d.Get("message").(string),
+		Start:        d.Get("start").(string),
+		End:          d.Get("end").(string),
+		AutoStart:    d.Get("auto_start").(bool),
+		AutoEnd:      d.Get("auto_end").(bool),
+		Notify:       d.Get("notify_subscribers").(bool),
+		NotifyBefore: []int{},
+		Components:   components,
+		Statuses:     statuses,
+	}
+
+	for _, minutes := range d.Get("notify_before_minutes").([]interface{}) {
+		maintenance.NotifyBefore = append(maintenance.NotifyBefore, minutes.(int))
+	}
+
+	name := expandStringMap(d.Get("name_translations").(map[string]interface{}))
+	message := expandStringMap(d.Get("message_translations").(map[string]interface{}))
+	if len(name) > 0 || len(message) > 0 {
+		maintenance.Translations = &MaintenanceTranslations{
+			Name:    name,
+			Message: message,
+		}
+	}
+
+	return maintenance
+}
+
+func resourceMaintenanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	created, err := client.CreateMaintenance(d.Get("page_id").(string), expandMaintenance(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating maintenance: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceMaintenanceRead(ctx, d, meta)
+}
+
+func resourceMaintenanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	maintenance, err := client.GetMaintenance(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading maintenance: %w", err))
+	}
+
+	if err := d.Set("name", maintenance.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("message", maintenance.Message); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("start", maintenance.Start); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("end", maintenance.End); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("auto_start", maintenance.AutoStart); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("auto_end", maintenance.AutoEnd); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("notify_before_minutes", maintenance.NotifyBefore); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("status", maintenance.Status); err != nil {
+		return diag.FromErr(err)
+	}
+	if maintenance.Translations != nil {
+		if err := d.Set("name_translations", maintenance.Translations.Name); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("message_translations", maintenance.Translations.Message); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// Component statuses only reflect the maintenance while it is in
+	// progress, so keep the configured statuses for known components
+	configured := make(map[string]string)
+	for _, item := range d.Get("components").(*schema.Set).List() {
+		m := item.(map[string]interface{})
+		configured[m["component_id"].(string)] = m["status"].(string)
+	}
+
+	components := make([]interface{}, 0, len(maintenance.Statuses))
+	for _, status := range maintenance.Statuses {
+		componentStatus := status.Status
+		if configuredStatus, ok := configured[status.ID]; ok {
+			componentStatus = configuredStatus
+		}
+		components = append(components, map[string]interface{}{
+			"component_id": status.ID,
+			"status":       componentStatus,
+		})
+	}
+	if err := d.Set("components", components); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceMaintenanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	_, err := client.UpdateMaintenance(d.Get("page_id").(string), d.Id(), expandMaintenance(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating maintenance: %w", err))
+	}
+
+	return resourceMaintenanceRead(ctx, d, meta)
+}
+
+func resourceMaintenanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteMaintenance(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting maintenance: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
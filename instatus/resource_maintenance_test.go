@@ -0,0 +1,193 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestValidateMaintenanceWindow(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		start      string
+		end        string
+		checkStart bool
+		checkEnd   bool
+		wantErr    string
+	}{
+		"valid future window": {
+			start: "2025-03-11T02:00:00Z",
+			end:   "2025-03-11T04:00:00Z",
+		},
+		"offsets are compared as instants": {
+			start: "2025-03-11T03:00:00+01:00",
+			end:   "2025-03-11T02:30:00Z",
+		},
+		"end before start": {
+			start:   "2025-03-11T04:00:00Z",
+			end:     "2025-03-11T02:00:00Z",
+			wantErr: "must be after start",
+		},
+		"end equal to start": {
+			start:   "2025-03-11T02:00:00Z",
+			end:     "2025-03-11T02:00:00Z",
+			wantErr: "must be after start",
+		},
+		"past window": {
+			start:      "2025-02-11T02:00:00Z",
+			end:        "2025-02-11T04:00:00Z",
+			checkStart: true,
+			checkEnd:   true,
+			wantErr:    "in the past",
+		},
+		"extending a window in progress": {
+			start:    "2025-03-01T11:00:00Z",
+			end:      "2025-03-01T14:00:00Z",
+			checkEnd: true,
+		},
+		"ending a window in progress in the past": {
+			start:    "2025-03-01T10:00:00Z",
+			end:      "2025-03-01T11:00:00Z",
+			checkEnd: true,
+			wantErr:  "end (2025-03-01T11:00:00Z) is in the past",
+		},
+		"past window already scheduled": {
+			start: "2025-02-11T02:00:00Z",
+			end:   "2025-02-11T04:00:00Z",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateMaintenanceWindow(tc.start, tc.end, tc.checkStart, tc.checkEnd, now)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestResourceMaintenanceCustomizeDiff_startedWindow(t *testing.T) {
+	start := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+	end := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	// Instatus returns timestamps in UTC with milliseconds
+	state := &terraform.InstanceState{
+		ID: "maintenance-id",
+		Attributes: map[string]string{
+			"id":      "maintenance-id",
+			"page_id": "page-id",
+			"name":    "Database patching",
+			"message": "The database will be patched.",
+			"start":   start.Format("2006-01-02T15:04:05.000Z"),
+			"end":     end.Format("2006-01-02T15:04:05.000Z"),
+		},
+	}
+
+	cases := map[string]struct {
+		end     time.Time
+		wantErr string
+	}{
+		"unchanged": {
+			end: end,
+		},
+		"extended": {
+			end: end.Add(time.Hour),
+		},
+		"ended in the past": {
+			end:     start.Add(time.Minute),
+			wantErr: "is in the past",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"page_id": "page-id",
+				"name":    "Database patching",
+				"message": "The database will be patched.",
+				"start":   start.Format(time.RFC3339),
+				"end":     tc.end.Format(time.RFC3339),
+			})
+
+			_, err := resourceMaintenance().Diff(context.Background(), state, config, nil)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccResourceMaintenance_basic(t *testing.T) {
+	start := time.Now().Add(7 * 24 * time.Hour).UTC().Truncate(time.Hour)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMaintenanceConfig(start, start.Add(2*time.Hour)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_maintenance.test", "name", "Database patching"),
+					resource.TestCheckResourceAttr("instatus_maintenance.test", "status", "NOTSTARTEDYET"),
+					resource.TestCheckResourceAttr("instatus_maintenance.test", "notify_before_minutes.#", "2"),
+				),
+			},
+			{
+				Config: testAccResourceMaintenanceConfig(start, start.Add(3*time.Hour)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_maintenance.test", "end", start.Add(3*time.Hour).Format(time.RFC3339)),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceMaintenanceConfig(start, end time.Time) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-maintenances"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "Database"
+}
+
+resource "instatus_maintenance" "test" {
+  page_id = instatus_page.test.id
+  name    = "Database patching"
+  message = "The database will be patched."
+  start   = %q
+  end     = %q
+
+  components {
+    component_id = instatus_component.test.id
+    status       = "UNDERMAINTENANCE"
+  }
+
+  notify_before_minutes = [1440, 60]
+}
+`, start.Format(time.RFC3339), end.Format(time.RFC3339))
+}
@@ -0,0 +1,169 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var metricDisplayTypes = []string{"LINE", "BAR"}
+
+func resourceMetric() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMetricCreate,
+		ReadContext:   resourceMetricRead,
+		UpdateContext: resourceMetricUpdate,
+		DeleteContext: resourceMetricDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMetricImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the metric",
+			},
+			"suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "The unit shown after each value (e.g. ms, %)",
+			},
+			"display_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "LINE",
+				ValidateFunc: validation.StringInSlice(metricDisplayTypes, false),
+				Description:  "How the metric is charted (LINE, BAR)",
+			},
+			"decimal_places": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 6),
+				Description:  "The number of decimal places values are shown with",
+			},
+			"public": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the metric is shown on the status page",
+			},
+			"order": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The position of the metric on the status page",
+			},
+		},
+	}
+}
+
+// resourceMetricImport accepts an ID of the form <page_id>/<metric_id>
+func resourceMetricImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, metricID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(metricID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandMetric(d *schema.ResourceData) *Metric {
+	return &Metric{
+		Name:          d.Get("name").(string),
+		Suffix:        d.Get("suffix").(string),
+		DisplayType:   d.Get("display_type").(string),
+		DecimalPlaces: d.Get("decimal_places").(int),
+		Public:        d.Get("public").(bool),
+		Order:         d.Get("order").(int),
+	}
+}
+
+func resourceMetricCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	created, err := client.CreateMetric(d.Get("page_id").(string), expandMetric(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating metric: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceMetricRead(ctx, d, meta)
+}
+
+func resourceMetricRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	metric, err := client.GetMetric(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading metric: %w", err))
+	}
+
+	if err := d.Set("name", metric.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("suffix", metric.Suffix); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("display_type", metric.DisplayType); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("decimal_places", metric.DecimalPlaces); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("public", metric.Public); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("order", metric.Order); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceMetricUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	_, err := client.UpdateMetric(d.Get("page_id").(string), d.Id(), expandMetric(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating metric: %w", err))
+	}
+
+	return resourceMetricRead(ctx, d, meta)
+}
+
+func resourceMetricDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteMetric(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting metric: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,56 @@
+package instatus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceMetric_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMetricConfig(0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_metric.test", "name", "API latency"),
+					resource.TestCheckResourceAttr("instatus_metric.test", "suffix", "ms"),
+					resource.TestCheckResourceAttr("instatus_metric.test", "display_type", "LINE"),
+					resource.TestCheckResourceAttr("instatus_metric.test", "public", "true"),
+				),
+			},
+			{
+				Config: testAccResourceMetricConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_metric.test", "decimal_places", "2"),
+				),
+			},
+			{
+				ResourceName:      "instatus_metric.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccPageScopedImportID("instatus_metric.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceMetricConfig(decimalPlaces int) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-metrics"
+  force_destroy  = true
+}
+
+resource "instatus_metric" "test" {
+  page_id        = instatus_page.test.id
+  name           = "API latency"
+  suffix         = "ms"
+  decimal_places = %d
+}
+`, decimalPlaces)
+}
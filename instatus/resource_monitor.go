@@ -0,0 +1,288 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	monitorTypes   = []string{"HTTP", "KEYWORD", "PING", "PORT"}
+	monitorRegions = []string{"us-east", "us-west", "eu-west", "eu-central", "ap-southeast", "ap-northeast"}
+)
+
+func resourceMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceMonitorCreate,
+		ReadContext:   resourceMonitorRead,
+		UpdateContext: resourceMonitorUpdate,
+		DeleteContext: resourceMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceMonitorImport,
+		},
+		CustomizeDiff: resourceMonitorCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the monitor",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(monitorTypes, false),
+				Description:  "The kind of check (HTTP, KEYWORD, PING, PORT)",
+			},
+			"target": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The URL checked by HTTP and KEYWORD monitors, or the host checked by PING and PORT monitors",
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IsPortNumber,
+				Description:  "The TCP port checked by PORT monitors",
+			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(30, 3600),
+				Description:  "Seconds between checks",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 60),
+				Description:  "Seconds before a check fails for lack of response",
+			},
+			"expected_status_codes": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The HTTP status codes counted as up for HTTP and KEYWORD monitors. Any 2xx code when empty",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(100, 599),
+				},
+			},
+			"keyword": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The text the response body must contain for KEYWORD monitors",
+			},
+			"headers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Request headers sent by HTTP and KEYWORD monitors",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"regions": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "The regions checks are run from. Chosen by Instatus when not set",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(monitorRegions, false),
+				},
+			},
+			"component_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The component whose status follows the monitor",
+			},
+			"failure_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "MAJOROUTAGE",
+				ValidateFunc: validation.StringInSlice(componentStatuses, false),
+				Description:  "The status set on the component while the monitor is failing",
+			},
+		},
+	}
+}
+
+// validateMonitorSettings checks that the settings used by the monitor
+// type are set and that settings other types use are not
+func validateMonitorSettings(monitorType string, port int, keyword string, hasStatusCodes, hasHeaders bool) error {
+	isHTTP := monitorType == "HTTP" || monitorType == "KEYWORD"
+
+	switch {
+	case monitorType == "PORT" && port == 0:
+		return fmt.Errorf("port is required for PORT monitors")
+	case monitorType != "PORT" && port != 0:
+		return fmt.Errorf("port can only be set for PORT monitors")
+	case monitorType == "KEYWORD" && keyword == "":
+		return fmt.Errorf("keyword is required for KEYWORD monitors")
+	case monitorType != "KEYWORD" && keyword != "":
+		return fmt.Errorf("keyword can only be set for KEYWORD monitors")
+	case !isHTTP && hasStatusCodes:
+		return fmt.Errorf("expected_status_codes can only be set for HTTP and KEYWORD monitors")
+	case !isHTTP && hasHeaders:
+		return fmt.Errorf("headers can only be set for HTTP and KEYWORD monitors")
+	}
+
+	return nil
+}
+
+func resourceMonitorCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"port", "keyword", "expected_status_codes", "headers"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	return validateMonitorSettings(
+		d.Get("type").(string),
+		d.Get("port").(int),
+		d.Get("keyword").(string),
+		d.Get("expected_status_codes").(*schema.Set).Len() > 0,
+		len(d.Get("headers").(map[string]interface{})) > 0,
+	)
+}
+
+// resourceMonitorImport accepts an ID of the form <page_id>/<monitor_id>
+func resourceMonitorImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, monitorID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(monitorID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandMonitor(d *schema.ResourceData) *Monitor {
+	monitor := &Monitor{
+		Name:          d.Get("name").(string),
+		Type:          d.Get("type").(string),
+		Target:        d.Get("target").(string),
+		Port:          d.Get("port").(int),
+		Interval:      d.Get("interval").(int),
+		Timeout:       d.Get("timeout").(int),
+		Keyword:       d.Get("keyword").(string),
+		Headers:       expandStringMap(d.Get("headers").(map[string]interface{})),
+		Regions:       expandStringList(d.Get("regions").(*schema.Set).List()),
+		ComponentID:   d.Get("component_id").(string),
+		FailureStatus: d.Get("failure_status").(string),
+	}
+
+	for _, code := range d.Get("expected_status_codes").(*schema.Set).List() {
+		monitor.ExpectedStatusCodes = append(monitor.ExpectedStatusCodes, code.(int))
+	}
+
+	return monitor
+}
+
+func resourceMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	created, err := client.CreateMonitor(d.Get("page_id").(string), expandMonitor(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating monitor: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceMonitorRead(ctx, d, meta)
+}
+
+func resourceMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	monitor, err := client.GetMonitor(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading monitor: %w", err))
+	}
+
+	if monitor.Type == heartbeatMonitorType {
+		return diag.Errorf("monitor %s is a heartbeat; manage it with instatus_heartbeat", d.Id())
+	}
+
+	if err := d.Set("name", monitor.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", monitor.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("target", monitor.Target); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("port", monitor.Port); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("interval", monitor.Interval); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("timeout", monitor.Timeout); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("expected_status_codes", monitor.ExpectedStatusCodes); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("keyword", monitor.Keyword); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setIfReturned(d, "headers", monitor.Headers); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("regions", monitor.Regions); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("component_id", monitor.ComponentID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("failure_status", monitor.FailureStatus); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	_, err := client.UpdateMonitor(d.Get("page_id").(string), d.Id(), expandMonitor(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating monitor: %w", err))
+	}
+
+	return resourceMonitorRead(ctx, d, meta)
+}
+
+func resourceMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteMonitor(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting monitor: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,129 @@
+package instatus
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateMonitorSettings(t *testing.T) {
+	cases := map[string]struct {
+		monitorType    string
+		port           int
+		keyword        string
+		hasStatusCodes bool
+		hasHeaders     bool
+		wantErr        string
+	}{
+		"http": {
+			monitorType:    "HTTP",
+			hasStatusCodes: true,
+			hasHeaders:     true,
+		},
+		"keyword": {
+			monitorType: "KEYWORD",
+			keyword:     "ok",
+		},
+		"port": {
+			monitorType: "PORT",
+			port:        5432,
+		},
+		"port without port": {
+			monitorType: "PORT",
+			wantErr:     "port is required",
+		},
+		"keyword without keyword": {
+			monitorType: "KEYWORD",
+			wantErr:     "keyword is required",
+		},
+		"ping with headers": {
+			monitorType: "PING",
+			hasHeaders:  true,
+			wantErr:     "headers can only be set",
+		},
+		"http with port": {
+			monitorType: "HTTP",
+			port:        443,
+			wantErr:     "port can only be set",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateMonitorSettings(tc.monitorType, tc.port, tc.keyword, tc.hasStatusCodes, tc.hasHeaders)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccResourceMonitor_http(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMonitorConfig(60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_monitor.test", "type", "HTTP"),
+					resource.TestCheckResourceAttr("instatus_monitor.test", "interval", "60"),
+					resource.TestCheckResourceAttr("instatus_monitor.test", "failure_status", "PARTIALOUTAGE"),
+					resource.TestCheckResourceAttrPair("instatus_monitor.test", "component_id", "instatus_component.test", "id"),
+				),
+			},
+			{
+				Config: testAccResourceMonitorConfig(300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_monitor.test", "interval", "300"),
+				),
+			},
+			{
+				ResourceName:            "instatus_monitor.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccPageScopedImportID("instatus_monitor.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"headers"},
+			},
+		},
+	})
+}
+
+func testAccResourceMonitorConfig(interval int) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-monitors"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "Website"
+}
+
+resource "instatus_monitor" "test" {
+  page_id               = instatus_page.test.id
+  name                  = "Website"
+  type                  = "HTTP"
+  target                = "https://example.com"
+  interval              = %d
+  expected_status_codes = [200, 301]
+  component_id          = instatus_component.test.id
+  failure_status        = "PARTIALOUTAGE"
+
+  headers = {
+    Authorization = "Bearer test"
+  }
+}
+`, interval)
+}
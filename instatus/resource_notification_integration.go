@@ -0,0 +1,181 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var (
+	notificationIntegrationTypes  = []string{"slack", "discord", "teams", "webhook"}
+	notificationIntegrationEvents = []string{"incidents", "maintenances", "component_changes"}
+)
+
+func resourceNotificationIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceNotificationIntegrationCreate,
+		ReadContext:   resourceNotificationIntegrationRead,
+		UpdateContext: resourceNotificationIntegrationUpdate,
+		DeleteContext: resourceNotificationIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceNotificationIntegrationImport,
+		},
+		CustomizeDiff: resourceNotificationIntegrationCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(notificationIntegrationTypes, false),
+				Description:  "Where events are posted (slack, discord, teams, webhook)",
+			},
+			"webhook_url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+				Description:  "The incoming webhook URL events are posted to",
+			},
+			"channel": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The Slack channel events are posted to, overriding the webhook's default channel",
+			},
+			"events": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Description: "The events that are posted (incidents, maintenances, component_changes)",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(notificationIntegrationEvents, false),
+				},
+			},
+		},
+	}
+}
+
+func resourceNotificationIntegrationCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("channel").(string) != "" && d.Get("type").(string) != "slack" {
+		return fmt.Errorf("channel can only be set for slack integrations")
+	}
+	return nil
+}
+
+// resourceNotificationIntegrationImport accepts an ID of the form
+// <page_id>/<integration_id>
+func resourceNotificationIntegrationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, integrationID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(integrationID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandNotificationIntegration(d *schema.ResourceData) *NotificationIntegration {
+	events := d.Get("events").(*schema.Set)
+
+	return &NotificationIntegration{
+		Type:         strings.ToUpper(d.Get("type").(string)),
+		URL:          d.Get("webhook_url").(string),
+		Channel:      d.Get("channel").(string),
+		Incidents:    events.Contains("incidents"),
+		Maintenances: events.Contains("maintenances"),
+		Components:   events.Contains("component_changes"),
+	}
+}
+
+func resourceNotificationIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	created, err := client.CreateNotificationIntegration(d.Get("page_id").(string), expandNotificationIntegration(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating notification integration: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceNotificationIntegrationRead(ctx, d, meta)
+}
+
+func resourceNotificationIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	integration, err := client.GetNotificationIntegration(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading notification integration: %w", err))
+	}
+
+	if err := d.Set("type", strings.ToLower(integration.Type)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := setIfReturned(d, "webhook_url", integration.URL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("channel", integration.Channel); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var events []string
+	if integration.Incidents {
+		events = append(events, "incidents")
+	}
+	if integration.Maintenances {
+		events = append(events, "maintenances")
+	}
+	if integration.Components {
+		events = append(events, "component_changes")
+	}
+	if err := d.Set("events", events); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceNotificationIntegrationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	_, err := client.UpdateNotificationIntegration(d.Get("page_id").(string), d.Id(), expandNotificationIntegration(d))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating notification integration: %w", err))
+	}
+
+	return resourceNotificationIntegrationRead(ctx, d, meta)
+}
+
+func resourceNotificationIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteNotificationIntegration(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting notification integration: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,55 @@
+package instatus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceNotificationIntegration_webhook(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceNotificationIntegrationConfig(`["incidents"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_notification_integration.test", "type", "webhook"),
+					resource.TestCheckResourceAttr("instatus_notification_integration.test", "events.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceNotificationIntegrationConfig(`["incidents", "maintenances", "component_changes"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_notification_integration.test", "events.#", "3"),
+				),
+			},
+			{
+				ResourceName:            "instatus_notification_integration.test",
+				ImportState:             true,
+				ImportStateIdFunc:       testAccPageScopedImportID("instatus_notification_integration.test"),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"webhook_url"},
+			},
+		},
+	})
+}
+
+func testAccResourceNotificationIntegrationConfig(events string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-integrations"
+  force_destroy  = true
+}
+
+resource "instatus_notification_integration" "test" {
+  page_id     = instatus_page.test.id
+  type        = "webhook"
+  webhook_url = "https://example.com/hooks/instatus"
+  events      = %s
+}
+`, events)
+}
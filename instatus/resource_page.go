@@ -2,12 +2,19 @@ package instatus
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+var hexColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
 func resourcePage() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourcePageCreate,
@@ -15,14 +22,19 @@ func resourcePage() *schema.Resource {
 		UpdateContext: resourcePageUpdate,
 		DeleteContext: resourcePageDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourcePageImport,
+		},
+		CustomizeDiff: resourcePageCustomizeDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
 			"email": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Billing email address for the status page workspace",
+				Description: "Billing email address for the status page workspace. Instatus does not return it, so it is not read back, including on import, and changes made outside Terraform are not detected",
 			},
 			"name": {
 				Type:        schema.TypeString,
@@ -37,8 +49,22 @@ func resourcePage() *schema.Resource {
 			},
 			"workspace_id": {
 				Type:        schema.TypeString,
+				Optional:    true,
 				Computed:    true,
-				Description: "Workspace ID returned by the Instatus API",
+				ForceNew:    true,
+				Description: "ID of an existing workspace to create the page in. When omitted, Instatus creates a new workspace",
+			},
+			"delete_workspace": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to also delete the page's workspace when the page is destroyed",
+			},
+			"force_destroy": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to delete the page's remaining subscribers, incidents, including resolved ones, and unmanaged components when it is destroyed. Without it, destroying a page that still holds any of them fails",
 			},
 			"logo_url": {
 				Type:        schema.TypeString,
@@ -58,12 +84,524 @@ func resourcePage() *schema.Resource {
 			"custom_domain": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Custom domain for the status page",
+				Description: "Custom domain for the status page. Removing it clears the domain, so pages whose domain is managed by instatus_custom_domain must ignore changes to it",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the status page in its default language",
+			},
+			"default_language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Default language of the page (e.g. en, fr, de)",
+			},
+			"languages": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "Languages the page is available in, in addition to the default language",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"name_translations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Localized names of the page, keyed by language code",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"description_translations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Localized descriptions of the page, keyed by language code",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"theme": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Colours, layout and custom HTML/CSS of the status page",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"brand_color":     pageColorSchema("Brand colour used for links and buttons"),
+						"ok_color":        pageColorSchema("Colour used for operational components"),
+						"disrupted_color": pageColorSchema("Colour used for partially disrupted components"),
+						"degraded_color":  pageColorSchema("Colour used for degraded components"),
+						"down_color":      pageColorSchema("Colour used for components with a major outage"),
+						"notice_color":    pageColorSchema("Colour used for notices"),
+						"unknown_color":   pageColorSchema("Colour used for components with an unknown status"),
+						"mode": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.StringInSlice([]string{"LIGHT", "DARK", "SYSTEM"}, false),
+							Description:  "Colour scheme of the page (LIGHT, DARK, SYSTEM)",
+						},
+						"custom_css": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom CSS applied to the whole page",
+						},
+						"html_above_header": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom HTML rendered above the header",
+						},
+						"html_below_header": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom HTML rendered below the header",
+						},
+						"html_above_footer": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom HTML rendered above the footer",
+						},
+						"html_below_footer": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Custom HTML rendered below the footer",
+						},
+						"use_large_header": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Computed:    true,
+							Description: "Whether to display the large header layout",
+						},
+						"uptime_bar_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntInSlice([]int{30, 60, 90}),
+							Description:  "Number of days shown in the component uptime bars (30, 60, 90)",
+						},
+					},
+				},
+			},
+			"access": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Access control settings for private status pages",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"private": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether the page is private",
+						},
+						"password": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "Password protecting the page. Instatus never returns it, so changes made outside Terraform are not detected",
+						},
+						"ip_allowlist": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "IP addresses or CIDR ranges allowed to view the page",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+							},
+						},
+						"sso": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "SAML or OIDC single sign-on for the page",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"protocol": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"SAML", "OIDC"}, false),
+										Description:  "Single sign-on protocol (SAML, OIDC)",
+									},
+									"metadata_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+										Description:  "SAML identity provider metadata URL",
+									},
+									"entity_id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "SAML identity provider entity ID",
+									},
+									"sso_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+										Description:  "SAML identity provider sign-on URL",
+									},
+									"certificate": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "SAML identity provider X.509 signing certificate (PEM)",
+									},
+									"issuer_url": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.IsURLWithHTTPS,
+										Description:  "OIDC issuer URL",
+									},
+									"client_id": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "OIDC client ID",
+									},
+									"client_secret": {
+										Type:        schema.TypeString,
+										Optional:    true,
+										Sensitive:   true,
+										Description: "OIDC client secret. Instatus never returns it, so changes made outside Terraform are not detected",
+									},
+									"allowed_domains": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										Description: "Email domains allowed to sign in",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"notifications": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Description: "Subscription channels offered on the page and how notifications are sent",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether visitors can subscribe by email",
+						},
+						"sms": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether visitors can subscribe by SMS",
+						},
+						"slack": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether visitors can subscribe with Slack",
+						},
+						"teams": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether visitors can subscribe with Microsoft Teams",
+						},
+						"webhook": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether visitors can subscribe with a webhook",
+						},
+						"rss": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the page offers an RSS/Atom feed",
+						},
+						"sender_name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Sender name used for notification emails",
+						},
+						"reply_to": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Reply-to address used for notification emails",
+						},
+						"language": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "Language of subscriber notifications (e.g. en, fr, de)",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func pageColorSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validation.StringMatch(hexColorRegexp, "must be a hex colour such as #4f46e5"),
+		Description:  description,
+	}
+}
+
+func expandPageTheme(v []interface{}) *PageTheme {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	m := v[0].(map[string]interface{})
+
+	return &PageTheme{
+		BrandColor:        m["brand_color"].(string),
+		OkColor:           m["ok_color"].(string),
+		DisruptedColor:    m["disrupted_color"].(string),
+		DegradedColor:     m["degraded_color"].(string),
+		DownColor:         m["down_color"].(string),
+		NoticeColor:       m["notice_color"].(string),
+		UnknownColor:      m["unknown_color"].(string),
+		Theme:             m["mode"].(string),
+		CSSGlobal:         m["custom_css"].(string),
+		HTMLAboveHeader:   m["html_above_header"].(string),
+		HTMLBelowHeader:   m["html_below_header"].(string),
+		HTMLAboveFooter:   m["html_above_footer"].(string),
+		HTMLBelowFooter:   m["html_below_footer"].(string),
+		UseLargeHeader:    m["use_large_header"].(bool),
+		UptimeDaysDisplay: m["uptime_bar_days"].(int),
+	}
+}
+
+func flattenPageTheme(theme *PageTheme) []interface{} {
+	if theme == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"brand_color":       theme.BrandColor,
+			"ok_color":          theme.OkColor,
+			"disrupted_color":   theme.DisruptedColor,
+			"degraded_color":    theme.DegradedColor,
+			"down_color":        theme.DownColor,
+			"notice_color":      theme.NoticeColor,
+			"unknown_color":     theme.UnknownColor,
+			"mode":              theme.Theme,
+			"custom_css":        theme.CSSGlobal,
+			"html_above_header": theme.HTMLAboveHeader,
+			"html_below_header": theme.HTMLBelowHeader,
+			"html_above_footer": theme.HTMLAboveFooter,
+			"html_below_footer": theme.HTMLBelowFooter,
+			"use_large_header":  theme.UseLargeHeader,
+			"uptime_bar_days":   theme.UptimeDaysDisplay,
+		},
+	}
+}
+
+func expandPageAccess(v []interface{}) *PageAccess {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	m := v[0].(map[string]interface{})
+
+	access := &PageAccess{
+		Private:     m["private"].(bool),
+		Password:    m["password"].(string),
+		IPAllowlist: expandStringList(m["ip_allowlist"].([]interface{})),
+	}
+
+	if sso := m["sso"].([]interface{}); len(sso) > 0 && sso[0] != nil {
+		s := sso[0].(map[string]interface{})
+		access.SSO = &PageSSO{
+			Protocol:       s["protocol"].(string),
+			MetadataURL:    s["metadata_url"].(string),
+			EntityID:       s["entity_id"].(string),
+			SSOURL:         s["sso_url"].(string),
+			Certificate:    s["certificate"].(string),
+			IssuerURL:      s["issuer_url"].(string),
+			ClientID:       s["client_id"].(string),
+			ClientSecret:   s["client_secret"].(string),
+			AllowedDomains: expandStringList(s["allowed_domains"].([]interface{})),
+		}
+	}
+
+	return access
+}
+
+// flattenPageAccess keeps the password and client secret from the current
+// state, as Instatus does not return them
+func flattenPageAccess(access *PageAccess, d *schema.ResourceData) []interface{} {
+	if access == nil {
+		return []interface{}{}
+	}
+
+	m := map[string]interface{}{
+		"private":      access.Private,
+		"password":     d.Get("access.0.password").(string),
+		"ip_allowlist": access.IPAllowlist,
+		"sso":          []interface{}{},
+	}
+
+	if access.SSO != nil {
+		m["sso"] = []interface{}{
+			map[string]interface{}{
+				"protocol":        access.SSO.Protocol,
+				"metadata_url":    access.SSO.MetadataURL,
+				"entity_id":       access.SSO.EntityID,
+				"sso_url":         access.SSO.SSOURL,
+				"certificate":     access.SSO.Certificate,
+				"issuer_url":      access.SSO.IssuerURL,
+				"client_id":       access.SSO.ClientID,
+				"client_secret":   d.Get("access.0.sso.0.client_secret").(string),
+				"allowed_domains": access.SSO.AllowedDomains,
 			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
+func expandPageNotificationSettings(v []interface{}) *PageNotificationSettings {
+	if len(v) == 0 || v[0] == nil {
+		return nil
+	}
+	m := v[0].(map[string]interface{})
+
+	return &PageNotificationSettings{
+		SubscribeByEmail:   m["email"].(bool),
+		SubscribeBySms:     m["sms"].(bool),
+		SubscribeBySlack:   m["slack"].(bool),
+		SubscribeByTeams:   m["teams"].(bool),
+		SubscribeByWebhook: m["webhook"].(bool),
+		SubscribeByRss:     m["rss"].(bool),
+		SenderName:         m["sender_name"].(string),
+		ReplyTo:            m["reply_to"].(string),
+		Language:           m["language"].(string),
+	}
+}
+
+func flattenPageNotificationSettings(settings *PageNotificationSettings) []interface{} {
+	if settings == nil {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"email":       settings.SubscribeByEmail,
+			"sms":         settings.SubscribeBySms,
+			"slack":       settings.SubscribeBySlack,
+			"teams":       settings.SubscribeByTeams,
+			"webhook":     settings.SubscribeByWebhook,
+			"rss":         settings.SubscribeByRss,
+			"sender_name": settings.SenderName,
+			"reply_to":    settings.ReplyTo,
+			"language":    settings.Language,
 		},
 	}
 }
 
+func expandPageTranslations(d *schema.ResourceData) *PageTranslations {
+	name := expandStringMap(d.Get("name_translations").(map[string]interface{}))
+	description := expandStringMap(d.Get("description_translations").(map[string]interface{}))
+	if len(name) == 0 && len(description) == 0 {
+		return nil
+	}
+
+	return &PageTranslations{
+		Name:        name,
+		Description: description,
+	}
+}
+
+// flattenPageTranslations drops the entry Instatus adds for the default
+// language unless it is part of the configuration, since it only repeats
+// the default value
+func flattenPageTranslations(translations map[string]string, defaultLanguage, defaultValue string, current map[string]interface{}) map[string]interface{} {
+	flattened := make(map[string]interface{}, len(translations))
+	for language, value := range translations {
+		if _, configured := current[language]; !configured && language == defaultLanguage && value == defaultValue {
+			continue
+		}
+		flattened[language] = value
+	}
+	return flattened
+}
+
+func expandStringMap(v map[string]interface{}) map[string]string {
+	m := make(map[string]string, len(v))
+	for key, value := range v {
+		m[key] = value.(string)
+	}
+	return m
+}
+
+func expandStringList(v []interface{}) []string {
+	list := make([]string, 0, len(v))
+	for _, item := range v {
+		if s, ok := item.(string); ok && s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+// resourcePageCustomizeDiff checks that SSO settings are complete for the
+// chosen protocol before anything is sent to Instatus
+func resourcePageCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("access") {
+		return nil
+	}
+
+	sso := d.Get("access.0.sso").([]interface{})
+	if len(sso) == 0 || sso[0] == nil {
+		return nil
+	}
+	s := sso[0].(map[string]interface{})
+
+	var required []string
+	switch s["protocol"].(string) {
+	case "SAML":
+		if s["metadata_url"].(string) == "" {
+			required = []string{"entity_id", "sso_url", "certificate"}
+		}
+	case "OIDC":
+		required = []string{"issuer_url", "client_id", "client_secret"}
+	}
+
+	for _, key := range required {
+		if s[key].(string) == "" {
+			return fmt.Errorf("access.0.sso.0.%s is required for %s single sign-on", key, s["protocol"].(string))
+		}
+	}
+
+	return nil
+}
+
+// resourcePageImport accepts either a page ID or slug:<workspace_slug>
+func resourcePageImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*Client)
+
+	if slug, ok := strings.CutPrefix(d.Id(), "slug:"); ok {
+		page, err := client.FindStatusPageBySlug(slug)
+		if err != nil {
+			return nil, fmt.Errorf("error importing status page: %w", err)
+		}
+		d.SetId(page.ID)
+	}
+
+	if err := d.Set("delete_workspace", false); err != nil {
+		return nil, err
+	}
+	if err := d.Set("force_destroy", false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourcePageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 
@@ -75,8 +613,16 @@ func resourcePageCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		FaviconURL:      d.Get("favicon_url").(string),
 		GoogleAnalytics: d.Get("google_analytics").(string),
 		CustomDomain:    d.Get("custom_domain").(string),
+		WorkspaceID:     d.Get("workspace_id").(string),
+		PageTheme:       expandPageTheme(d.Get("theme").([]interface{})),
+		PageAccess:      expandPageAccess(d.Get("access").([]interface{})),
+		Description:     d.Get("description").(string),
+		DefaultLanguage: d.Get("default_language").(string),
+		Languages:       expandStringList(d.Get("languages").([]interface{})),
+		Translations:    expandPageTranslations(d),
 	}
 
+	start := time.Now()
 	created, err := client.CreateStatusPage(page)
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("error creating status page: %w", err))
@@ -90,7 +636,23 @@ func resourcePageCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(err)
 	}
 
-	return nil
+	// Components can't be created until the page is readable
+	err = waitUntilReadable(ctx, d.Timeout(schema.TimeoutCreate)-time.Since(start), func() error {
+		_, err := client.GetStatusPage(created.ID)
+		return err
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error waiting for status page to be provisioned: %w", err))
+	}
+
+	// Notification settings live on a separate endpoint
+	if settings := expandPageNotificationSettings(d.Get("notifications").([]interface{})); settings != nil {
+		if _, err := client.UpdatePageNotificationSettings(created.ID, settings); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating status page notification settings: %w", err))
+		}
+	}
+
+	return resourcePageRead(ctx, d, meta)
 }
 
 func resourcePageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -101,6 +663,10 @@ func resourcePageRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	page, err := client.GetStatusPage(pageID)
 	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
 		return diag.FromErr(fmt.Errorf("error reading status page: %w", err))
 	}
 
@@ -113,6 +679,53 @@ func resourcePageRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	if err := d.Set("workspace_id", page.WorkspaceID); err != nil {
 		return diag.FromErr(err)
 	}
+	if err := d.Set("logo_url", page.LogoURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("favicon_url", page.FaviconURL); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("google_analytics", page.GoogleAnalytics); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("custom_domain", page.CustomDomain); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", page.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("default_language", page.DefaultLanguage); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("languages", page.Languages); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nameTranslations, descriptionTranslations map[string]string
+	if page.Translations != nil {
+		nameTranslations = page.Translations.Name
+		descriptionTranslations = page.Translations.Description
+	}
+	if err := d.Set("name_translations", flattenPageTranslations(nameTranslations, page.DefaultLanguage, page.Name, d.Get("name_translations").(map[string]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description_translations", flattenPageTranslations(descriptionTranslations, page.DefaultLanguage, page.Description, d.Get("description_translations").(map[string]interface{}))); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("theme", flattenPageTheme(page.PageTheme)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("access", flattenPageAccess(page.PageAccess, d)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	settings, err := client.GetPageNotificationSettings(pageID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading status page notification settings: %w", err))
+	}
+	if err := d.Set("notifications", flattenPageNotificationSettings(settings)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -123,8 +736,19 @@ func resourcePageUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	pageID := d.Id()
 
 	page := &PageUpdate{
-		Email: d.Get("email").(string),
-		Name:  d.Get("name").(string),
+		Email:           d.Get("email").(string),
+		Name:            d.Get("name").(string),
+		WorkspaceSlug:   d.Get("workspace_slug").(string),
+		LogoURL:         d.Get("logo_url").(string),
+		FaviconURL:      d.Get("favicon_url").(string),
+		GoogleAnalytics: d.Get("google_analytics").(string),
+		CustomDomain:    d.Get("custom_domain").(string),
+		PageTheme:       expandPageTheme(d.Get("theme").([]interface{})),
+		PageAccess:      expandPageAccess(d.Get("access").([]interface{})),
+		Description:     d.Get("description").(string),
+		DefaultLanguage: d.Get("default_language").(string),
+		Languages:       expandStringList(d.Get("languages").([]interface{})),
+		Translations:    expandPageTranslations(d),
 	}
 
 	_, err := client.UpdateStatusPage(pageID, page)
@@ -132,21 +756,174 @@ func resourcePageUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 		return diag.FromErr(fmt.Errorf("error updating status page: %w", err))
 	}
 
-	return diag.Diagnostics{}
-	// TODO: Re-enable after Instatus Has a valid API response for GET page
-	// return resourcePageRead(ctx, d, meta)
+	if d.HasChange("notifications") {
+		if settings := expandPageNotificationSettings(d.Get("notifications").([]interface{})); settings != nil {
+			if _, err := client.UpdatePageNotificationSettings(pageID, settings); err != nil {
+				return diag.FromErr(fmt.Errorf("error updating status page notification settings: %w", err))
+			}
+		}
+	}
+
+	return resourcePageRead(ctx, d, meta)
 }
 
 func resourcePageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*Client)
 	pageID := d.Id()
 	workspaceID := d.Get("workspace_id").(string)
+	var diags diag.Diagnostics
 
-	err := client.DeleteStatusPage(pageID, workspaceID)
-	if err != nil {
-		return diag.FromErr(fmt.Errorf("error deleting status page: %w", err))
+	// Terraform does not run CustomizeDiff on destroy, so the guard can only
+	// run here, at apply. Managed components are destroyed before their page,
+	// so any component still on the page is unmanaged.
+	children, err := listPageChildren(client, pageID)
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error listing status page contents: %w", err))
+	}
+
+	if !children.empty() {
+		if !d.Get("force_destroy").(bool) {
+			return diag.Diagnostics{
+				{
+					Severity: diag.Error,
+					Summary:  "Status page is not empty",
+					Detail:   fmt.Sprintf("The status page still holds %s. Set force_destroy = true and apply before destroying to remove them along with the page.", children.summary()),
+				},
+			}
+		}
+
+		removed, err := deletePageChildren(client, pageID, children)
+		if !removed.empty() {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Removed %s from the status page", removed.summary()),
+				Detail:   removed.detail(),
+			})
+		}
+		if err != nil {
+			return append(diags, diag.FromErr(fmt.Errorf("error emptying status page: %w", err))...)
+		}
+	}
+
+	var errs []error
+
+	if err := client.DeleteStatusPage(pageID); err != nil && !IsNotFound(err) {
+		errs = append(errs, fmt.Errorf("error deleting status page: %w", err))
+	}
+
+	if d.Get("delete_workspace").(bool) {
+		if err := client.DeleteWorkspace(workspaceID); err != nil && !IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("error deleting workspace %s: %w", workspaceID, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return append(diags, diag.FromErr(err)...)
 	}
 
 	d.SetId("")
-	return nil
+	return diags
+}
+
+// pageChildren holds the objects that keep a status page from being
+// destroyed without force_destroy
+type pageChildren struct {
+	Components  []Component
+	Incidents   []Incident
+	Subscribers []Subscriber
+}
+
+func listPageChildren(client *Client, pageID string) (pageChildren, error) {
+	var children pageChildren
+	var err error
+
+	if children.Components, err = client.ListComponents(pageID); err != nil {
+		return pageChildren{}, err
+	}
+	if children.Incidents, err = client.ListIncidents(pageID); err != nil {
+		return pageChildren{}, err
+	}
+	if children.Subscribers, err = client.ListSubscribers(pageID); err != nil {
+		return pageChildren{}, err
+	}
+
+	return children, nil
+}
+
+// deletePageChildren deletes every child it can and returns those it removed
+// along with every failure
+func deletePageChildren(client *Client, pageID string, children pageChildren) (pageChildren, error) {
+	var removed pageChildren
+	var errs []error
+
+	for _, subscriber := range children.Subscribers {
+		if err := client.DeleteSubscriber(pageID, subscriber.ID); err != nil && !IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("error deleting subscriber %s: %w", subscriber.ID, err))
+			continue
+		}
+		removed.Subscribers = append(removed.Subscribers, subscriber)
+	}
+
+	for _, incident := range children.Incidents {
+		if err := client.DeleteIncident(pageID, incident.ID); err != nil && !IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("error deleting incident %q: %w", incident.Name, err))
+			continue
+		}
+		removed.Incidents = append(removed.Incidents, incident)
+	}
+
+	for _, component := range children.Components {
+		if err := client.DeleteComponent(component.ID, pageID); err != nil && !IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("error deleting component %q: %w", component.Name, err))
+			continue
+		}
+		removed.Components = append(removed.Components, component)
+	}
+
+	return removed, errors.Join(errs...)
+}
+
+func (c pageChildren) empty() bool {
+	return len(c.Components) == 0 && len(c.Incidents) == 0 && len(c.Subscribers) == 0
+}
+
+// summary describes the children as e.g. "2 components and 1 subscriber"
+func (c pageChildren) summary() string {
+	var parts []string
+	for _, count := range []struct {
+		n        int
+		singular string
+	}{
+		{len(c.Components), "component"},
+		{len(c.Incidents), "incident"},
+		{len(c.Subscribers), "subscriber"},
+	} {
+		switch {
+		case count.n == 1:
+			parts = append(parts, "1 "+count.singular)
+		case count.n > 1:
+			parts = append(parts, fmt.Sprintf("%d %ss", count.n, count.singular))
+		}
+	}
+
+	if len(parts) <= 1 {
+		return strings.Join(parts, "")
+	}
+	return strings.Join(parts[:len(parts)-1], ", ") + " and " + parts[len(parts)-1]
+}
+
+// detail lists components and incidents by name. Subscribers are only
+// counted to keep their contact details out of the output.
+func (c pageChildren) detail() string {
+	var lines []string
+	for _, component := range c.Components {
+		lines = append(lines, fmt.Sprintf("component %q (%s)", component.Name, component.ID))
+	}
+	for _, incident := range c.Incidents {
+		lines = append(lines, fmt.Sprintf("incident %q (%s)", incident.Name, incident.ID))
+	}
+	if len(c.Subscribers) > 0 {
+		lines = append(lines, fmt.Sprintf("%d subscriber(s)", len(c.Subscribers)))
+	}
+	return strings.Join(lines, "\n")
 }
@@ -1,9 +1,17 @@
 package instatus
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func TestAccResourcePage_basic(t *testing.T) {
@@ -33,3 +41,219 @@ resource "instatus_page" "test" {
 }
 `
 }
+
+func TestAccResourcePage_theme(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePageConfig_theme("#4f46e5", "DARK"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_page.test", "theme.0.brand_color", "#4f46e5"),
+					resource.TestCheckResourceAttr("instatus_page.test", "theme.0.mode", "DARK"),
+					resource.TestCheckResourceAttr("instatus_page.test", "theme.0.uptime_bar_days", "90"),
+				),
+			},
+			{
+				Config: testAccResourcePageConfig_theme("#16a34a", "LIGHT"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_page.test", "theme.0.brand_color", "#16a34a"),
+					resource.TestCheckResourceAttr("instatus_page.test", "theme.0.mode", "LIGHT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePageConfig_theme(brandColor, mode string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-theme"
+
+  theme {
+    brand_color     = %q
+    mode            = %q
+    uptime_bar_days = 90
+  }
+}
+`, brandColor, mode)
+}
+
+func TestAccResourcePage_access(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePageConfig_access(true, "203.0.113.0/24"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_page.test", "access.0.private", "true"),
+					resource.TestCheckResourceAttr("instatus_page.test", "access.0.ip_allowlist.#", "1"),
+					resource.TestCheckResourceAttr("instatus_page.test", "access.0.ip_allowlist.0", "203.0.113.0/24"),
+				),
+			},
+			{
+				Config: testAccResourcePageConfig_access(false, "198.51.100.7"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_page.test", "access.0.private", "false"),
+					resource.TestCheckResourceAttr("instatus_page.test", "access.0.ip_allowlist.0", "198.51.100.7"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePageConfig_access(private bool, allowed string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-access"
+
+  access {
+    private      = %t
+    ip_allowlist = [%q]
+  }
+}
+`, private, allowed)
+}
+
+func TestAccResourcePage_notifications(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePageConfig_notifications(false, "Example Status"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_page.test", "notifications.0.email", "true"),
+					resource.TestCheckResourceAttr("instatus_page.test", "notifications.0.sms", "false"),
+					resource.TestCheckResourceAttr("instatus_page.test", "notifications.0.sender_name", "Example Status"),
+					resource.TestCheckResourceAttr("instatus_page.test", "notifications.0.reply_to", "support@example.com"),
+				),
+			},
+			{
+				Config: testAccResourcePageConfig_notifications(true, "Example Support"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_page.test", "notifications.0.slack", "true"),
+					resource.TestCheckResourceAttr("instatus_page.test", "notifications.0.sender_name", "Example Support"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcePageConfig_notifications(slack bool, senderName string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-notifications"
+
+  notifications {
+    email       = true
+    sms         = false
+    slack       = %t
+    sender_name = %q
+    reply_to    = "support@example.com"
+  }
+}
+`, slack, senderName)
+}
+
+func TestAccResourcePage_importBySlug(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcePageConfig_basic(),
+			},
+			{
+				ResourceName:      "instatus_page.test",
+				ImportState:       true,
+				ImportStateId:     "slug:test-page",
+				ImportStateVerify: true,
+				// Instatus does not return the email, so import cannot set it
+				ImportStateVerifyIgnore: []string{"email"},
+			},
+		},
+	})
+}
+
+func TestResourcePageDelete_children(t *testing.T) {
+	cases := map[string]struct {
+		forceDestroy bool
+		wantErr      string
+		wantDeletes  []string
+	}{
+		"without force_destroy": {
+			wantErr: "2 incidents and 1 subscriber",
+		},
+		"with force_destroy": {
+			forceDestroy: true,
+			wantDeletes: []string{
+				"/v1/page-id/incidents/open",
+				"/v1/page-id/incidents/resolved",
+				"/v1/page-id/subscribers/subscriber-id",
+				"/v2/page-id",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var mu sync.Mutex
+			var deletes []string
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == "DELETE":
+					mu.Lock()
+					deletes = append(deletes, r.URL.Path)
+					mu.Unlock()
+					fmt.Fprint(w, `{}`)
+				case r.URL.Path == "/v2/page-id/components":
+					fmt.Fprint(w, `[]`)
+				case r.URL.Path == "/v1/page-id/incidents":
+					fmt.Fprint(w, `[
+						{"id": "open", "name": "Outage", "status": "INVESTIGATING"},
+						{"id": "resolved", "name": "Old outage", "status": "RESOLVED"}
+					]`)
+				case r.URL.Path == "/v1/page-id/subscribers":
+					fmt.Fprint(w, `[{"id": "subscriber-id", "email": "user@example.com"}]`)
+				default:
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+				}
+			})
+
+			d := schema.TestResourceDataRaw(t, resourcePage().Schema, map[string]interface{}{
+				"force_destroy": tc.forceDestroy,
+			})
+			d.SetId("page-id")
+
+			diags := resourcePageDelete(context.Background(), d, client)
+
+			if tc.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags[0].Detail, tc.wantErr) {
+					t.Fatalf("got diagnostics %#v, want an error mentioning %q", diags, tc.wantErr)
+				}
+				if len(deletes) > 0 {
+					t.Fatalf("got deletes %v, want none", deletes)
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+			sort.Strings(deletes)
+			if !reflect.DeepEqual(deletes, tc.wantDeletes) {
+				t.Fatalf("got deletes %v, want %v", deletes, tc.wantDeletes)
+			}
+		})
+	}
+}
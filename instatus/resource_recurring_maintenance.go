@@ -0,0 +1,545 @@
+package instatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/robfig/cron/v3"
+	"github.com/teambition/rrule-go"
+)
+
+// recurringMaintenanceContentKeys are the arguments copied onto every
+// materialized maintenance
+var recurringMaintenanceContentKeys = []string{
+	"name", "message", "components", "auto_start", "auto_end", "notify_subscribers", "notify_before_minutes",
+}
+
+// recurringMaintenanceScheduleKeys are the arguments that decide when the
+// windows fall
+var recurringMaintenanceScheduleKeys = []string{
+	"rrule", "cron", "dtstart", "duration", "timezone", "horizon_days", "window_count",
+}
+
+func resourceRecurringMaintenance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRecurringMaintenanceCreate,
+		ReadContext:   resourceRecurringMaintenanceRead,
+		UpdateContext: resourceRecurringMaintenanceUpdate,
+		DeleteContext: resourceRecurringMaintenanceDelete,
+		CustomizeDiff: resourceRecurringMaintenanceCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of each maintenance",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The message announcing each maintenance",
+			},
+			"rrule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"rrule", "cron"},
+				Description:  "iCalendar recurrence rule for the window starts (e.g. FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;BYHOUR=2;BYMINUTE=0;BYSECOND=0)",
+			},
+			"cron": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"rrule", "cron"},
+				Description:  "Five-field cron expression for the window starts (e.g. 0 2 * * 2)",
+			},
+			"dtstart": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339,
+				Description:      "Anchor of the recurrence rule, in RFC3339 format. Defaults to the creation time",
+			},
+			"duration": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateDuration,
+				Description:  "Length of each window as a duration (e.g. 2h, 90m)",
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "UTC",
+				ValidateFunc: validateTimezone,
+				Description:  "IANA timezone the recurrence is evaluated in (e.g. Europe/London)",
+			},
+			"horizon_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: validation.IntBetween(1, 365),
+				Description:  "How many days ahead windows are scheduled",
+			},
+			"window_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validation.IntBetween(1, 50),
+				Description:  "Maximum number of upcoming windows to keep scheduled",
+			},
+			"components": affectedComponentsSchema("The components affected by each maintenance along with their status during the window", false),
+			"auto_start": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether Instatus starts each maintenance automatically",
+			},
+			"auto_end": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether Instatus completes each maintenance automatically",
+			},
+			"notify_subscribers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether to notify subscribers when a maintenance is scheduled or changed",
+			},
+			"notify_before_minutes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Lead times, in minutes before each start, at which subscribers are reminded",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
+			},
+			"windows": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The upcoming maintenance windows scheduled on Instatus",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"maintenance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the maintenance",
+						},
+						"start": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the window starts",
+						},
+						"end": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "When the window ends",
+						},
+					},
+				},
+			},
+			"maintenance_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the upcoming maintenances, in start order",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	duration, err := time.ParseDuration(v.(string))
+	if err != nil {
+		return nil, []error{fmt.Errorf("%s: invalid duration %q: %w", k, v, err)}
+	}
+	if duration <= 0 {
+		return nil, []error{fmt.Errorf("%s: duration must be positive", k)}
+	}
+	return nil, nil
+}
+
+func validateTimezone(v interface{}, k string) ([]string, []error) {
+	if _, err := time.LoadLocation(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%s: unknown timezone %q", k, v)}
+	}
+	return nil, nil
+}
+
+// maintenanceWindow is one occurrence of a recurring maintenance
+type maintenanceWindow struct {
+	MaintenanceID string
+	Start         time.Time
+	End           time.Time
+}
+
+// recurrence describes when the windows of a recurring maintenance fall
+type recurrence struct {
+	RRule       string
+	Cron        string
+	DTStart     time.Time
+	Duration    time.Duration
+	Location    *time.Location
+	Horizon     time.Duration
+	WindowCount int
+}
+
+// windows returns the windows starting after now and within the horizon,
+// capped at WindowCount
+func (r recurrence) windows(now time.Time) ([]maintenanceWindow, error) {
+	now = now.In(r.Location)
+	until := now.Add(r.Horizon)
+
+	var starts []time.Time
+	switch {
+	case r.RRule != "":
+		option, err := rrule.StrToROption(r.RRule)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rrule %q: %w", r.RRule, err)
+		}
+		option.Dtstart = r.DTStart.In(r.Location)
+		rule, err := rrule.NewRRule(*option)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rrule %q: %w", r.RRule, err)
+		}
+		iterator := rule.Iterator()
+		for start, ok := iterator(); ok && !start.After(until) && len(starts) < r.WindowCount; start, ok = iterator() {
+			if start.After(now) {
+				starts = append(starts, start)
+			}
+		}
+	case r.Cron != "":
+		schedule, err := cron.ParseStandard(r.Cron)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %w", r.Cron, err)
+		}
+		for start := schedule.Next(now); !start.IsZero() && !start.After(until) && len(starts) < r.WindowCount; start = schedule.Next(start) {
+			starts = append(starts, start)
+		}
+	default:
+		return nil, errors.New("one of rrule or cron must be set")
+	}
+
+	windows := make([]maintenanceWindow, 0, len(starts))
+	for _, start := range starts {
+		windows = append(windows, maintenanceWindow{Start: start, End: start.Add(r.Duration)})
+	}
+
+	return windows, nil
+}
+
+// recurrenceFrom reads the schedule from either the plan or the state. The
+// rule is anchored at dtstart, or at defaultDTStart when dtstart is empty.
+func recurrenceFrom(d interface{ Get(string) interface{} }, dtstart string, defaultDTStart time.Time) (recurrence, error) {
+	location, err := time.LoadLocation(d.Get("timezone").(string))
+	if err != nil {
+		return recurrence{}, fmt.Errorf("unknown timezone %q", d.Get("timezone").(string))
+	}
+
+	duration, err := time.ParseDuration(d.Get("duration").(string))
+	if err != nil {
+		return recurrence{}, fmt.Errorf("invalid duration %q: %w", d.Get("duration").(string), err)
+	}
+
+	anchor := defaultDTStart
+	if dtstart != "" {
+		if anchor, err = time.Parse(time.RFC3339, dtstart); err != nil {
+			return recurrence{}, fmt.Errorf("invalid dtstart %q: %w", dtstart, err)
+		}
+	}
+
+	return recurrence{
+		RRule:       d.Get("rrule").(string),
+		Cron:        d.Get("cron").(string),
+		DTStart:     anchor,
+		Duration:    duration,
+		Location:    location,
+		Horizon:     time.Duration(d.Get("horizon_days").(int)) * 24 * time.Hour,
+		WindowCount: d.Get("window_count").(int),
+	}, nil
+}
+
+// resourceRecurringMaintenanceCustomizeDiff validates the schedule at plan
+// time and plans an update whenever the upcoming windows no longer match
+// the state, so that each apply rolls the windows forward
+func resourceRecurringMaintenanceCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	// dtstart is computed when not configured, so it is unknown on create
+	// and defaults to the creation time. It must not skip validation.
+	for _, key := range recurringMaintenanceScheduleKeys {
+		if key != "dtstart" && !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	var dtstart string
+	if d.NewValueKnown("dtstart") {
+		dtstart = d.Get("dtstart").(string)
+	}
+
+	schedule, err := recurrenceFrom(d, dtstart, time.Now())
+	if err != nil {
+		return err
+	}
+	expected, err := schedule.windows(time.Now())
+	if err != nil {
+		return err
+	}
+	if len(expected) == 0 {
+		return fmt.Errorf("the schedule has no windows in the next %d days", d.Get("horizon_days").(int))
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	current := expandMaintenanceWindows(d.Get("windows").([]interface{}))
+	if !sameWindows(expected, current) {
+		if err := d.SetNewComputed("windows"); err != nil {
+			return err
+		}
+		if err := d.SetNewComputed("maintenance_ids"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func sameWindows(expected, current []maintenanceWindow) bool {
+	if len(expected) != len(current) {
+		return false
+	}
+	for i := range expected {
+		if !expected[i].Start.Equal(current[i].Start) || !expected[i].End.Equal(current[i].End) {
+			return false
+		}
+	}
+	return true
+}
+
+func expandMaintenanceWindows(v []interface{}) []maintenanceWindow {
+	windows := make([]maintenanceWindow, 0, len(v))
+	for _, item := range v {
+		m := item.(map[string]interface{})
+		start, _ := time.Parse(time.RFC3339, m["start"].(string))
+		end, _ := time.Parse(time.RFC3339, m["end"].(string))
+		windows = append(windows, maintenanceWindow{
+			MaintenanceID: m["maintenance_id"].(string),
+			Start:         start,
+			End:           end,
+		})
+	}
+	return windows
+}
+
+func setMaintenanceWindows(d *schema.ResourceData, windows []maintenanceWindow) error {
+	sort.Slice(windows, func(i, j int) bool { return windows[i].Start.Before(windows[j].Start) })
+
+	flattened := make([]interface{}, 0, len(windows))
+	ids := make([]string, 0, len(windows))
+	for _, window := range windows {
+		flattened = append(flattened, map[string]interface{}{
+			"maintenance_id": window.MaintenanceID,
+			"start":          window.Start.Format(time.RFC3339),
+			"end":            window.End.Format(time.RFC3339),
+		})
+		ids = append(ids, window.MaintenanceID)
+	}
+
+	if err := d.Set("windows", flattened); err != nil {
+		return err
+	}
+	return d.Set("maintenance_ids", ids)
+}
+
+// recurringMaintenanceFor builds the maintenance for a single window
+func recurringMaintenanceFor(d *schema.ResourceData, window maintenanceWindow) *Maintenance {
+	components, statuses := expandAffectedComponents(d.Get("components").(*schema.Set).List())
+
+	maintenance := &Maintenance{
+		Name:         d.Get("name").(string),
+		Message:      d.Get("message").(string),
+		Start:        window.Start.Format(time.RFC3339),
+		End:          window.End.Format(time.RFC3339),
+		AutoStart:    d.Get("auto_start").(bool),
+		AutoEnd:      d.Get("auto_end").(bool),
+		Notify:       d.Get("notify_subscribers").(bool),
+		NotifyBefore: []int{},
+		Components:   components,
+		Statuses:     statuses,
+	}
+
+	for _, minutes := range d.Get("notify_before_minutes").([]interface{}) {
+		maintenance.NotifyBefore = append(maintenance.NotifyBefore, minutes.(int))
+	}
+
+	return maintenance
+}
+
+// syncMaintenanceWindows creates, updates and deletes maintenances so that
+// the upcoming windows match the schedule. Windows that have already
+// started are left to Instatus and dropped from state.
+func syncMaintenanceWindows(d *schema.ResourceData, client *Client, now time.Time) error {
+	pageID := d.Get("page_id").(string)
+
+	schedule, err := recurrenceFrom(d, d.Get("dtstart").(string), now)
+	if err != nil {
+		return err
+	}
+	expected, err := schedule.windows(now)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[int64]maintenanceWindow)
+	for _, window := range expandMaintenanceWindows(d.Get("windows").([]interface{})) {
+		if window.Start.After(now) {
+			existing[window.Start.Unix()] = window
+		}
+	}
+
+	contentChanged := d.HasChanges(recurringMaintenanceContentKeys...)
+
+	var synced []maintenanceWindow
+	var errs []error
+
+	for _, window := range expected {
+		if current, ok := existing[window.Start.Unix()]; ok && current.End.Equal(window.End) {
+			delete(existing, window.Start.Unix())
+			if contentChanged {
+				if _, err := client.UpdateMaintenance(pageID, current.MaintenanceID, recurringMaintenanceFor(d, window)); err != nil {
+					errs = append(errs, fmt.Errorf("error updating maintenance %s: %w", current.MaintenanceID, err))
+				}
+			}
+			synced = append(synced, current)
+			continue
+		}
+
+		created, err := client.CreateMaintenance(pageID, recurringMaintenanceFor(d, window))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error creating maintenance for %s: %w", window.Start.Format(time.RFC3339), err))
+			continue
+		}
+		window.MaintenanceID = created.ID
+		synced = append(synced, window)
+	}
+
+	for _, window := range existing {
+		if err := client.DeleteMaintenance(pageID, window.MaintenanceID); err != nil && !IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("error deleting maintenance %s: %w", window.MaintenanceID, err))
+			synced = append(synced, window)
+		}
+	}
+
+	if err := setMaintenanceWindows(d, synced); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func resourceRecurringMaintenanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	now := time.Now()
+
+	if _, ok := d.GetOk("dtstart"); !ok {
+		if err := d.Set("dtstart", now.UTC().Truncate(time.Minute).Format(time.RFC3339)); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", d.Get("page_id").(string), now.UnixNano()))
+
+	if err := syncMaintenanceWindows(d, client, now); err != nil {
+		if len(d.Get("windows").([]interface{})) == 0 {
+			d.SetId("")
+		}
+		return diag.FromErr(fmt.Errorf("error scheduling recurring maintenance: %w", err))
+	}
+
+	return resourceRecurringMaintenanceRead(ctx, d, meta)
+}
+
+// resourceRecurringMaintenanceRead drops windows that were deleted outside
+// Terraform and picks up changes to their times
+func resourceRecurringMaintenanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+	pageID := d.Get("page_id").(string)
+	now := time.Now()
+
+	var windows []maintenanceWindow
+	for _, window := range expandMaintenanceWindows(d.Get("windows").([]interface{})) {
+		if !window.Start.After(now) {
+			continue
+		}
+
+		maintenance, err := client.GetMaintenance(pageID, window.MaintenanceID)
+		if err != nil {
+			if IsNotFound(err) {
+				continue
+			}
+			return diag.FromErr(fmt.Errorf("error reading maintenance %s: %w", window.MaintenanceID, err))
+		}
+
+		if start, err := time.Parse(time.RFC3339, maintenance.Start); err == nil {
+			window.Start = start
+		}
+		if end, err := time.Parse(time.RFC3339, maintenance.End); err == nil {
+			window.End = end
+		}
+		windows = append(windows, window)
+	}
+
+	if err := setMaintenanceWindows(d, windows); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceRecurringMaintenanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	if err := syncMaintenanceWindows(d, client, time.Now()); err != nil {
+		return diag.FromErr(fmt.Errorf("error updating recurring maintenance: %w", err))
+	}
+
+	return resourceRecurringMaintenanceRead(ctx, d, meta)
+}
+
+// resourceRecurringMaintenanceDelete deletes the windows that have not started yet
+func resourceRecurringMaintenanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	pageID := d.Get("page_id").(string)
+	now := time.Now()
+
+	var errs []error
+	for _, window := range expandMaintenanceWindows(d.Get("windows").([]interface{})) {
+		if !window.Start.After(now) {
+			continue
+		}
+		if err := client.DeleteMaintenance(pageID, window.MaintenanceID); err != nil && !IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("error deleting maintenance %s: %w", window.MaintenanceID, err))
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,194 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestRecurrenceWindows(t *testing.T) {
+	now := time.Date(2025, 3, 5, 12, 0, 0, 0, time.UTC)
+	london, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		recurrence recurrence
+		want       []string
+		wantErr    string
+	}{
+		"every second tuesday": {
+			recurrence: recurrence{
+				RRule:       "FREQ=WEEKLY;INTERVAL=2;BYDAY=TU;BYHOUR=2;BYMINUTE=0;BYSECOND=0",
+				DTStart:     time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC),
+				Duration:    2 * time.Hour,
+				Location:    time.UTC,
+				Horizon:     30 * 24 * time.Hour,
+				WindowCount: 5,
+			},
+			want: []string{"2025-03-18T02:00:00Z", "2025-04-01T02:00:00Z"},
+		},
+		"cron capped at window count": {
+			recurrence: recurrence{
+				Cron:        "0 2 * * 2",
+				Duration:    2 * time.Hour,
+				Location:    time.UTC,
+				Horizon:     30 * 24 * time.Hour,
+				WindowCount: 3,
+			},
+			want: []string{"2025-03-11T02:00:00Z", "2025-03-18T02:00:00Z", "2025-03-25T02:00:00Z"},
+		},
+		"cron follows daylight saving time": {
+			recurrence: recurrence{
+				Cron:        "0 2 * * 2",
+				Duration:    time.Hour,
+				Location:    london,
+				Horizon:     30 * 24 * time.Hour,
+				WindowCount: 5,
+			},
+			want: []string{"2025-03-11T02:00:00Z", "2025-03-18T02:00:00Z", "2025-03-25T02:00:00Z", "2025-04-01T02:00:00+01:00"},
+		},
+		"invalid rrule": {
+			recurrence: recurrence{
+				RRule:       "FREQ=SOMETIMES",
+				Location:    time.UTC,
+				WindowCount: 5,
+			},
+			wantErr: "invalid rrule",
+		},
+		"invalid cron": {
+			recurrence: recurrence{
+				Cron:        "every tuesday",
+				Location:    time.UTC,
+				WindowCount: 5,
+			},
+			wantErr: "invalid cron expression",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			windows, err := tc.recurrence.windows(now)
+			if tc.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+					t.Fatalf("got error %v, want it to contain %q", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []string
+			for _, window := range windows {
+				got = append(got, window.Start.Format(time.RFC3339))
+				if !window.End.Equal(window.Start.Add(tc.recurrence.Duration)) {
+					t.Errorf("window starting %s ends at %s", window.Start, window.End)
+				}
+			}
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Fatalf("got windows %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestResourceRecurringMaintenance_planValidation(t *testing.T) {
+	cases := map[string]struct {
+		config  map[string]interface{}
+		wantErr string
+	}{
+		"valid rrule without dtstart": {
+			config: map[string]interface{}{"rrule": "FREQ=WEEKLY;BYDAY=TU;BYHOUR=2;BYMINUTE=0;BYSECOND=0"},
+		},
+		"valid cron without dtstart": {
+			config: map[string]interface{}{"cron": "0 2 * * 2"},
+		},
+		"invalid rrule without dtstart": {
+			config:  map[string]interface{}{"rrule": "FREQ=SOMETIMES"},
+			wantErr: "invalid rrule",
+		},
+		"invalid rrule with dtstart": {
+			config:  map[string]interface{}{"rrule": "FREQ=SOMETIMES", "dtstart": "2025-03-04T00:00:00Z"},
+			wantErr: "invalid rrule",
+		},
+		"cron that never fires without dtstart": {
+			config:  map[string]interface{}{"cron": "0 2 31 2 *"},
+			wantErr: "no windows",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			raw := map[string]interface{}{
+				"page_id":  "page-id",
+				"name":     "Database patching",
+				"message":  "The database will be patched.",
+				"duration": "2h",
+			}
+			for key, value := range tc.config {
+				raw[key] = value
+			}
+
+			_, err := resourceRecurringMaintenance().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(raw), nil)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccResourceRecurringMaintenance_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceRecurringMaintenanceConfig(3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_recurring_maintenance.test", "windows.#", "3"),
+					resource.TestCheckResourceAttr("instatus_recurring_maintenance.test", "maintenance_ids.#", "3"),
+				),
+			},
+			{
+				Config: testAccResourceRecurringMaintenanceConfig(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_recurring_maintenance.test", "windows.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceRecurringMaintenanceConfig(windowCount int) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-recurring-maintenances"
+  force_destroy  = true
+}
+
+resource "instatus_recurring_maintenance" "test" {
+  page_id      = instatus_page.test.id
+  name         = "Database patching"
+  message      = "The database will be patched."
+  cron         = "0 2 * * 2"
+  duration     = "2h"
+  horizon_days = 60
+  window_count = %d
+}
+`, windowCount)
+}
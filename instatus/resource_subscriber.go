@@ -0,0 +1,276 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var subscriberTypes = []string{"email", "sms", "webhook", "slack"}
+
+func resourceSubscriber() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSubscriberCreate,
+		ReadContext:   resourceSubscriberRead,
+		DeleteContext: resourceSubscriberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSubscriberImport,
+		},
+		CustomizeDiff: resourceSubscriberCustomizeDiff,
+
+		// Instatus has no endpoint for updating subscribers, so every
+		// change replaces the subscription
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(subscriberTypes, false),
+				Description:  "The channel notifications are sent through (email, sms, webhook, slack)",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The address of an email subscriber, or the contact address of a webhook subscriber",
+			},
+			"phone": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The phone number of an SMS subscriber, in international format",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "The URL notifications are posted to for webhook and Slack subscribers",
+			},
+			"component_ids": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The components the subscriber is notified about. Subscribes to all components when empty",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"language": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The language of the notifications",
+			},
+			"skip_confirmation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Whether to confirm the subscription without sending a confirmation message",
+			},
+			"confirmed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the subscriber has confirmed the subscription",
+			},
+		},
+	}
+}
+
+// subscriberAddressKeys maps each subscriber type to the argument holding
+// its address
+var subscriberAddressKeys = map[string]string{
+	"email":   "email",
+	"sms":     "phone",
+	"webhook": "url",
+	"slack":   "url",
+}
+
+// validateSubscriberAddress checks that the address matching the type is
+// set and that no other address is
+func validateSubscriberAddress(subscriberType, email, phone, url string) error {
+	addresses := map[string]string{"email": email, "phone": phone, "url": url}
+
+	required := subscriberAddressKeys[subscriberType]
+	if addresses[required] == "" {
+		return fmt.Errorf("%s is required for %s subscribers", required, subscriberType)
+	}
+
+	for key, value := range addresses {
+		if key == required || value == "" {
+			continue
+		}
+		// Webhook subscribers may give a contact email
+		if subscriberType == "webhook" && key == "email" {
+			continue
+		}
+		return fmt.Errorf("%s cannot be set for %s subscribers", key, subscriberType)
+	}
+
+	return nil
+}
+
+func resourceSubscriberCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	for _, key := range []string{"type", "email", "phone", "url"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	return validateSubscriberAddress(
+		d.Get("type").(string),
+		d.Get("email").(string),
+		d.Get("phone").(string),
+		d.Get("url").(string),
+	)
+}
+
+// resourceSubscriberImport accepts an ID of the form <page_id>/<subscriber_id>
+func resourceSubscriberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, subscriberID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(subscriberID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+	if err := d.Set("skip_confirmation", false); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// expandSubscriber builds the subscriber from its type, address and options
+func expandSubscriber(subscriberType, email, phone, url string, componentIDs []string, language string, skipConfirmation bool) *Subscriber {
+	subscriber := &Subscriber{
+		Email:       email,
+		All:         len(componentIDs) == 0,
+		Components:  componentIDs,
+		Language:    language,
+		AutoConfirm: skipConfirmation,
+	}
+
+	switch subscriberType {
+	case "sms":
+		subscriber.Phone = phone
+	case "webhook":
+		subscriber.Webhook = url
+	case "slack":
+		subscriber.Slack = url
+	}
+
+	return subscriber
+}
+
+// subscriberType infers the type of a subscriber returned by the API
+func subscriberType(subscriber *Subscriber) string {
+	switch {
+	case subscriber.Slack != "":
+		return "slack"
+	case subscriber.Webhook != "":
+		return "webhook"
+	case subscriber.Phone != "":
+		return "sms"
+	default:
+		return "email"
+	}
+}
+
+func resourceSubscriberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	subscriber := expandSubscriber(
+		d.Get("type").(string),
+		d.Get("email").(string),
+		d.Get("phone").(string),
+		d.Get("url").(string),
+		expandStringList(d.Get("component_ids").(*schema.Set).List()),
+		d.Get("language").(string),
+		d.Get("skip_confirmation").(bool),
+	)
+
+	created, err := client.CreateSubscriber(d.Get("page_id").(string), subscriber)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating subscriber: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceSubscriberRead(ctx, d, meta)
+}
+
+func resourceSubscriberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	subscriber, err := client.GetSubscriber(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading subscriber: %w", err))
+	}
+
+	if err := d.Set("type", subscriberType(subscriber)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", subscriber.Email); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := setIfReturned(d, "phone", subscriber.Phone); err != nil {
+		return diag.FromErr(err)
+	}
+	url := subscriber.Webhook
+	if url == "" {
+		url = subscriber.Slack
+	}
+	if err := setIfReturned(d, "url", url); err != nil {
+		return diag.FromErr(err)
+	}
+
+	componentIDs := subscriber.Components
+	if subscriber.All {
+		componentIDs = nil
+	}
+	if err := d.Set("component_ids", componentIDs); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("language", subscriber.Language); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("confirmed", subscriber.Confirmed); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSubscriberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteSubscriber(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting subscriber: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
@@ -0,0 +1,104 @@
+package instatus
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestValidateSubscriberAddress(t *testing.T) {
+	cases := map[string]struct {
+		subscriberType string
+		email          string
+		phone          string
+		url            string
+		wantErr        string
+	}{
+		"email": {
+			subscriberType: "email",
+			email:          "cs@example.com",
+		},
+		"sms": {
+			subscriberType: "sms",
+			phone:          "+15555550100",
+		},
+		"webhook with contact email": {
+			subscriberType: "webhook",
+			email:          "oncall@example.com",
+			url:            "https://example.com/hooks/instatus",
+		},
+		"missing address": {
+			subscriberType: "slack",
+			wantErr:        "url is required",
+		},
+		"conflicting address": {
+			subscriberType: "email",
+			email:          "cs@example.com",
+			phone:          "+15555550100",
+			wantErr:        "phone cannot be set",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := validateSubscriberAddress(tc.subscriberType, tc.email, tc.phone, tc.url)
+			if tc.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErr) {
+				t.Fatalf("got error %v, want it to contain %q", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestAccResourceSubscriber_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSubscriberConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_subscriber.test", "type", "email"),
+					resource.TestCheckResourceAttr("instatus_subscriber.test", "email", "subscriber@example.com"),
+					resource.TestCheckResourceAttr("instatus_subscriber.test", "component_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "instatus_subscriber.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccPageScopedImportID("instatus_subscriber.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceSubscriberConfig() string {
+	return `
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-subscribers"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "API"
+}
+
+resource "instatus_subscriber" "test" {
+  page_id           = instatus_page.test.id
+  type              = "email"
+  email             = "subscriber@example.com"
+  component_ids     = [instatus_component.test.id]
+  skip_confirmation = true
+}
+`
+}
@@ -0,0 +1,352 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSubscribers() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSubscribersCreate,
+		ReadContext:   resourceSubscribersRead,
+		UpdateContext: resourceSubscribersUpdate,
+		DeleteContext: resourceSubscribersDelete,
+		CustomizeDiff: resourceSubscribersCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"skip_confirmation": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to confirm added subscriptions without sending confirmation messages",
+			},
+			"subscriber": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "The subscribers of the page managed by this resource",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "email",
+							ValidateFunc: validation.StringInSlice(subscriberTypes, false),
+							Description:  "The channel notifications are sent through (email, sms, webhook, slack)",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The address of an email subscriber, or the contact address of a webhook subscriber",
+						},
+						"phone": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The phone number of an SMS subscriber, in international format",
+						},
+						"url": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Sensitive:    true,
+							ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+							Description:  "The URL notifications are posted to for webhook and Slack subscribers",
+						},
+						"component_ids": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "The components the subscriber is notified about. Subscribes to all components when empty",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"language": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The language of the notifications",
+						},
+					},
+				},
+			},
+			"subscriber_ids": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The IDs of the managed subscribers, keyed by <type>:<address>",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// subscriberEntryKey identifies a subscriber entry by its type and address
+func subscriberEntryKey(entry map[string]interface{}) string {
+	subscriberType := entry["type"].(string)
+	return fmt.Sprintf("%s:%s", subscriberType, entry[subscriberAddressKeys[subscriberType]].(string))
+}
+
+func expandSubscriberEntry(entry map[string]interface{}, skipConfirmation bool) *Subscriber {
+	return expandSubscriber(
+		entry["type"].(string),
+		entry["email"].(string),
+		entry["phone"].(string),
+		entry["url"].(string),
+		expandStringList(entry["component_ids"].(*schema.Set).List()),
+		entry["language"].(string),
+		skipConfirmation,
+	)
+}
+
+// resourceSubscribersCustomizeDiff validates every entry at plan time and
+// rejects entries sharing an address
+func resourceSubscribersCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("subscriber") {
+		return nil
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range d.Get("subscriber").(*schema.Set).List() {
+		entry := item.(map[string]interface{})
+		if err := validateSubscriberAddress(entry["type"].(string), entry["email"].(string), entry["phone"].(string), entry["url"].(string)); err != nil {
+			return fmt.Errorf("invalid subscriber: %w", err)
+		}
+
+		key := subscriberEntryKey(entry)
+		if seen[key] {
+			return fmt.Errorf("the same %s subscriber is listed more than once", entry["type"].(string))
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// addSubscribers creates the entries and records the IDs of those that
+// succeeded. Entries that fail are dropped from state, so the next plan
+// adds them again, and reported as warnings.
+func addSubscribers(d *schema.ResourceData, client *Client, entries []interface{}, ids map[string]interface{}, managed *schema.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	skipConfirmation := d.Get("skip_confirmation").(bool)
+
+	subscribers := make([]*Subscriber, 0, len(entries))
+	for _, item := range entries {
+		subscribers = append(subscribers, expandSubscriberEntry(item.(map[string]interface{}), skipConfirmation))
+	}
+
+	for i, result := range client.CreateSubscribers(d.Get("page_id").(string), subscribers) {
+		entry := entries[i].(map[string]interface{})
+		if result.Err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Could not add %s subscriber", entry["type"].(string)),
+				Detail:   fmt.Sprintf("Subscriber %d of %d was not added and will be retried on the next apply: %s", i+1, len(entries), result.Err),
+			})
+			continue
+		}
+		ids[subscriberEntryKey(entry)] = result.Subscriber.ID
+		managed.Add(entry)
+	}
+
+	return diags
+}
+
+// removeSubscribers deletes the entries. Entries that fail stay in state,
+// so the next plan removes them again, and are reported as warnings.
+func removeSubscribers(d *schema.ResourceData, client *Client, entries []interface{}, ids map[string]interface{}, managed *schema.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	keys := make([]string, 0, len(entries))
+	subscriberIDs := make([]string, 0, len(entries))
+	for _, item := range entries {
+		entry := item.(map[string]interface{})
+		key := subscriberEntryKey(entry)
+		id, ok := ids[key].(string)
+		if !ok {
+			continue
+		}
+		keys = append(keys, key)
+		subscriberIDs = append(subscriberIDs, id)
+	}
+
+	for i, err := range client.DeleteSubscribers(d.Get("page_id").(string), subscriberIDs) {
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Could not remove subscriber",
+				Detail:   fmt.Sprintf("Subscriber %s was not removed and will be retried on the next apply: %s", subscriberIDs[i], err),
+			})
+			continue
+		}
+		delete(ids, keys[i])
+	}
+
+	// Drop every entry whose subscriber is gone, including entries that
+	// never had one
+	for _, item := range managed.List() {
+		if _, ok := ids[subscriberEntryKey(item.(map[string]interface{}))]; !ok {
+			managed.Remove(item)
+		}
+	}
+
+	return diags
+}
+
+func resourceSubscribersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	entries := d.Get("subscriber").(*schema.Set)
+	managed := schema.NewSet(entries.F, nil)
+	ids := make(map[string]interface{})
+
+	diags := addSubscribers(d, client, entries.List(), ids, managed)
+
+	// Several sets of subscribers may share a page, so the page ID alone
+	// cannot identify the resource
+	d.SetId(fmt.Sprintf("%s/%d", d.Get("page_id").(string), time.Now().UnixNano()))
+	if err := d.Set("subscriber", managed); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("subscriber_ids", ids); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceSubscribersRead(ctx, d, meta)...)
+}
+
+// resourceSubscribersRead drops entries whose subscriber was removed outside
+// Terraform, so the next plan adds them again, and refreshes the components
+// and configured language of the others, so the next plan replaces those
+// changed outside Terraform. Subscribers not created by this resource are ignored.
+func resourceSubscribersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	subscribers, err := client.ListSubscribers(d.Get("page_id").(string))
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading subscribers: %w", err))
+	}
+
+	existing := make(map[string]Subscriber, len(subscribers))
+	for _, subscriber := range subscribers {
+		existing[subscriber.ID] = subscriber
+	}
+
+	ids := make(map[string]interface{})
+	for key, id := range d.Get("subscriber_ids").(map[string]interface{}) {
+		if _, ok := existing[id.(string)]; ok {
+			ids[key] = id
+		}
+	}
+
+	entries := d.Get("subscriber").(*schema.Set)
+	managed := schema.NewSet(entries.F, nil)
+	for _, item := range entries.List() {
+		entry := item.(map[string]interface{})
+		id, ok := ids[subscriberEntryKey(entry)]
+		if !ok {
+			continue
+		}
+
+		subscriber := existing[id.(string)]
+		componentIDs := schema.NewSet(schema.HashString, nil)
+		if !subscriber.All {
+			for _, componentID := range subscriber.Components {
+				componentIDs.Add(componentID)
+			}
+		}
+
+		refreshed := make(map[string]interface{}, len(entry))
+		for key, value := range entry {
+			refreshed[key] = value
+		}
+		refreshed["component_ids"] = componentIDs
+		// Instatus fills in a default language, so it is only compared when
+		// the entry sets one
+		if entry["language"].(string) != "" {
+			refreshed["language"] = subscriber.Language
+		}
+		managed.Add(refreshed)
+	}
+
+	if err := d.Set("subscriber", managed); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("subscriber_ids", ids); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceSubscribersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	o, n := d.GetChange("subscriber")
+	oldEntries, newEntries := o.(*schema.Set), n.(*schema.Set)
+
+	managed := schema.NewSet(oldEntries.F, oldEntries.List())
+	ids := d.Get("subscriber_ids").(map[string]interface{})
+
+	// Changed entries are removed before being added again
+	diags = append(diags, removeSubscribers(d, client, oldEntries.Difference(newEntries).List(), ids, managed)...)
+
+	var additions []interface{}
+	for _, item := range newEntries.Difference(oldEntries).List() {
+		if _, ok := ids[subscriberEntryKey(item.(map[string]interface{}))]; ok {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Subscriber not updated",
+				Detail:   "A changed subscriber could not be removed, so it was not added again with its new settings. It will be retried on the next apply.",
+			})
+			continue
+		}
+		additions = append(additions, item)
+	}
+	diags = append(diags, addSubscribers(d, client, additions, ids, managed)...)
+
+	if err := d.Set("subscriber", managed); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+	if err := d.Set("subscriber_ids", ids); err != nil {
+		return append(diags, diag.FromErr(err)...)
+	}
+
+	return append(diags, resourceSubscribersRead(ctx, d, meta)...)
+}
+
+func resourceSubscribersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	ids := d.Get("subscriber_ids").(map[string]interface{})
+	subscriberIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		subscriberIDs = append(subscriberIDs, id.(string))
+	}
+
+	for i, err := range client.DeleteSubscribers(d.Get("page_id").(string), subscriberIDs) {
+		if err != nil {
+			diags = append(diags, diag.Errorf("error deleting subscriber %s: %s", subscriberIDs[i], err)...)
+		}
+	}
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId("")
+
+	return diags
+}
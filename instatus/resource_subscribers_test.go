@@ -0,0 +1,122 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResourceSubscribersRead_outsideChanges(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/v1/page-id/subscribers" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[
+			{"id": "alice-id", "email": "alice@example.com", "all": false, "components": ["api-id"], "language": "fr"},
+			{"id": "bob-id", "email": "bob@example.com", "all": true, "language": "en"},
+			{"id": "dave-id", "email": "dave@example.com", "all": true, "language": "de"}
+		]`)
+	})
+
+	d := schema.TestResourceDataRaw(t, resourceSubscribers().Schema, map[string]interface{}{
+		"page_id": "page-id",
+		"subscriber": []interface{}{
+			map[string]interface{}{"email": "alice@example.com", "language": "en"},
+			map[string]interface{}{"email": "bob@example.com"},
+			map[string]interface{}{"email": "carol@example.com"},
+			map[string]interface{}{"email": "dave@example.com", "language": "de"},
+		},
+	})
+	d.SetId("page-id/1")
+	if err := d.Set("subscriber_ids", map[string]interface{}{
+		"email:alice@example.com": "alice-id",
+		"email:bob@example.com":   "bob-id",
+		"email:carol@example.com": "carol-id",
+		"email:dave@example.com":  "dave-id",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if diags := resourceSubscribersRead(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("unexpected error: %#v", diags)
+	}
+
+	entries := make(map[string]map[string]interface{})
+	for _, item := range d.Get("subscriber").(*schema.Set).List() {
+		entry := item.(map[string]interface{})
+		entries[entry["email"].(string)] = entry
+	}
+
+	if _, ok := entries["carol@example.com"]; ok || len(entries) != 3 {
+		t.Fatalf("got subscribers %v, want alice, bob and dave", entries)
+	}
+	alice := entries["alice@example.com"]
+	if components := alice["component_ids"].(*schema.Set).List(); len(components) != 1 || components[0] != "api-id" || alice["language"] != "fr" {
+		t.Fatalf("got alice %v, want the components and language set outside Terraform", alice)
+	}
+	// Bob has no configured language, so the default Instatus filled in is
+	// not a change
+	if bob := entries["bob@example.com"]; bob["component_ids"].(*schema.Set).Len() != 0 || bob["language"] != "" {
+		t.Fatalf("got bob %v, want no components or language", bob)
+	}
+	if dave := entries["dave@example.com"]; dave["language"] != "de" {
+		t.Fatalf("got dave %v, want the configured language kept", dave)
+	}
+	if ids := d.Get("subscriber_ids").(map[string]interface{}); len(ids) != 3 {
+		t.Fatalf("got subscriber IDs %v, want alice, bob and dave", ids)
+	}
+}
+
+func TestAccResourceSubscribers_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSubscribersConfig("alice@example.com", "bob@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_subscribers.test", "subscriber.#", "2"),
+					resource.TestCheckResourceAttr("instatus_subscribers.test", "subscriber_ids.%", "2"),
+				),
+			},
+			{
+				Config: testAccResourceSubscribersConfig("alice@example.com", "carol@example.com", "dave@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_subscribers.test", "subscriber.#", "3"),
+					resource.TestCheckResourceAttr("instatus_subscribers.test", "subscriber_ids.%", "3"),
+					resource.TestCheckNoResourceAttr("instatus_subscribers.test", "subscriber_ids.email:bob@example.com"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceSubscribersConfig(emails ...string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-bulk-subscribers"
+  force_destroy  = true
+}
+
+resource "instatus_subscribers" "test" {
+  page_id           = instatus_page.test.id
+  skip_confirmation = true
+
+  dynamic "subscriber" {
+    for_each = toset(["%s"])
+    content {
+      email = subscriber.value
+    }
+  }
+}
+`, strings.Join(emails, `", "`))
+}
@@ -0,0 +1,149 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var teamMemberRoles = []string{"admin", "member", "viewer"}
+
+func resourceTeamMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTeamMemberCreate,
+		ReadContext:   resourceTeamMemberRead,
+		UpdateContext: resourceTeamMemberUpdate,
+		DeleteContext: resourceTeamMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTeamMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"workspace_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"workspace_id", "page_id"},
+				Description:  "The ID of the workspace to grant access to",
+			},
+			"page_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"workspace_id", "page_id"},
+				Description:  "The ID of the status page to grant access to",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The email address of the team member",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(teamMemberRoles, false),
+				Description:  "The role of the team member (admin, member, viewer)",
+			},
+			"pending": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the invitation has not been accepted yet",
+			},
+		},
+	}
+}
+
+// resourceTeamMemberImport accepts an ID of the form
+// workspace/<workspace_id>/<member_id> or page/<page_id>/<member_id>
+func resourceTeamMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || (parts[0] != "workspace" && parts[0] != "page") || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected ID %q, expected workspace/<workspace_id>/<member_id> or page/<page_id>/<member_id>", d.Id())
+	}
+
+	d.SetId(parts[2])
+	if err := d.Set(parts[0]+"_id", parts[1]); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceTeamMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	member := &TeamMember{
+		Email: d.Get("email").(string),
+		Role:  d.Get("role").(string),
+	}
+
+	created, err := client.CreateTeamMember(d.Get("workspace_id").(string), d.Get("page_id").(string), member)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating team member: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceTeamMemberRead(ctx, d, meta)
+}
+
+func resourceTeamMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	member, err := client.GetTeamMember(d.Get("workspace_id").(string), d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading team member: %w", err))
+	}
+
+	if err := d.Set("email", member.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("role", strings.ToLower(member.Role)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("pending", member.Pending); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceTeamMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	member := &TeamMember{
+		Email: d.Get("email").(string),
+		Role:  d.Get("role").(string),
+	}
+
+	_, err := client.UpdateTeamMember(d.Get("workspace_id").(string), d.Get("page_id").(string), d.Id(), member)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating team member: %w", err))
+	}
+
+	return resourceTeamMemberRead(ctx, d, meta)
+}
+
+func resourceTeamMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteTeamMember(d.Get("workspace_id").(string), d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting team member: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
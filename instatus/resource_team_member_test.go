@@ -0,0 +1,59 @@
+package instatus
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccResourceTeamMember_workspace(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTeamMemberConfig("viewer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_team_member.test", "email", "member@example.com"),
+					resource.TestCheckResourceAttr("instatus_team_member.test", "role", "viewer"),
+					resource.TestCheckResourceAttr("instatus_team_member.test", "pending", "true"),
+				),
+			},
+			{
+				Config: testAccResourceTeamMemberConfig("admin"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_team_member.test", "role", "admin"),
+				),
+			},
+			{
+				ResourceName:      "instatus_team_member.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["instatus_team_member.test"]
+					if !ok {
+						return "", fmt.Errorf("resource not found: instatus_team_member.test")
+					}
+					return fmt.Sprintf("workspace/%s/%s", rs.Primary.Attributes["workspace_id"], rs.Primary.ID), nil
+				},
+			},
+		},
+	})
+}
+
+func testAccResourceTeamMemberConfig(role string) string {
+	return fmt.Sprintf(`
+resource "instatus_workspace" "test" {
+  name = "Test Workspace"
+  slug = "test-workspace-team"
+}
+
+resource "instatus_team_member" "test" {
+  workspace_id = instatus_workspace.test.id
+  email        = "member@example.com"
+  role         = %q
+}
+`, role)
+}
@@ -0,0 +1,309 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	incidentTemplateType    = "INCIDENT"
+	maintenanceTemplateType = "MAINTENANCE"
+)
+
+func resourceIncidentTemplate() *schema.Resource {
+	return resourceTemplate(incidentTemplateType)
+}
+
+func resourceMaintenanceTemplate() *schema.Resource {
+	return resourceTemplate(maintenanceTemplateType)
+}
+
+// resourceTemplate builds the resource for incident or maintenance
+// templates, which only differ in their type and default status
+func resourceTemplate(templateType string) *schema.Resource {
+	r := &schema.Resource{
+		CreateContext: resourceTemplateCreate(templateType),
+		ReadContext:   resourceTemplateRead,
+		UpdateContext: resourceTemplateUpdate(templateType),
+		DeleteContext: resourceTemplateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTemplateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the template, as shown to responders",
+			},
+			"title": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The default name of created incidents or maintenances",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The default message of created incidents or maintenances",
+			},
+			"components": affectedComponentsSchema("The components affected by default, along with their status", false),
+		},
+	}
+
+	// Maintenances always start as NOTSTARTEDYET, so only incident
+	// templates have a default status
+	if templateType == incidentTemplateType {
+		r.Schema["status"] = &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice(incidentStatuses, false),
+			Description:  "The default status of created incidents (INVESTIGATING, IDENTIFIED, MONITORING, RESOLVED)",
+		}
+	}
+
+	return r
+}
+
+// resourceTemplateImport accepts an ID of the form <page_id>/<template_id>
+func resourceTemplateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	pageID, templateID, err := parsePageScopedID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(templateID)
+	if err := d.Set("page_id", pageID); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandTemplate(d *schema.ResourceData, templateType string) *Template {
+	components, statuses := expandAffectedComponents(d.Get("components").(*schema.Set).List())
+
+	template := &Template{
+		Type:       templateType,
+		Name:       d.Get("name").(string),
+		Title:      d.Get("title").(string),
+		Message:    d.Get("message").(string),
+		Components: components,
+		Statuses:   statuses,
+	}
+	if status, ok := d.GetOk("status"); ok {
+		template.Status = status.(string)
+	}
+
+	return template
+}
+
+func flattenAffectedComponents(statuses []AffectedComponent) []interface{} {
+	components := make([]interface{}, 0, len(statuses))
+	for _, status := range statuses {
+		components = append(components, map[string]interface{}{
+			"component_id": status.ID,
+			"status":       status.Status,
+		})
+	}
+	return components
+}
+
+func resourceTemplateCreate(templateType string) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*Client)
+
+		created, err := client.CreateTemplate(d.Get("page_id").(string), expandTemplate(d, templateType))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error creating template: %w", err))
+		}
+
+		d.SetId(created.ID)
+
+		return resourceTemplateRead(ctx, d, meta)
+	}
+}
+
+func resourceTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	template, err := client.GetTemplate(d.Get("page_id").(string), d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading template: %w", err))
+	}
+
+	if err := d.Set("name", template.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("title", template.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("message", template.Message); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("components", flattenAffectedComponents(template.Statuses)); err != nil {
+		return diag.FromErr(err)
+	}
+	if _, ok := d.GetOk("status"); ok || template.Status != "" {
+		if err := d.Set("status", template.Status); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return diags
+}
+
+func resourceTemplateUpdate(templateType string) schema.UpdateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*Client)
+
+		_, err := client.UpdateTemplate(d.Get("page_id").(string), d.Id(), expandTemplate(d, templateType))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating template: %w", err))
+		}
+
+		return resourceTemplateRead(ctx, d, meta)
+	}
+}
+
+func resourceTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteTemplate(d.Get("page_id").(string), d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting template: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
+
+// templateIDSchema is the template_id argument of incidents and maintenances
+func templateIDSchema(templateType string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: fmt.Sprintf("The ID of an %s template filling in the arguments that are not set", map[string]string{incidentTemplateType: "incident", maintenanceTemplateType: "maintenance"}[templateType]),
+	}
+}
+
+// templatedSchema marks an argument a template may fill in as computed, so
+// that the filled in value does not show as drift
+func templatedSchema(s *schema.Schema) *schema.Schema {
+	s.Computed = true
+	return s
+}
+
+// configUnset reports whether an argument is absent from the configuration.
+// Blocks that are not written appear as empty collections.
+func configUnset(d *schema.ResourceDiff, key string) bool {
+	v := d.GetRawConfig().GetAttr(key)
+	if v.IsNull() {
+		return true
+	}
+	return v.IsKnown() && v.Type().IsCollectionType() && v.LengthInt() == 0
+}
+
+// templateDefaults returns the template values of the arguments it fills in
+func templateDefaults(template *Template) map[string]interface{} {
+	return map[string]interface{}{
+		"name":       template.Title,
+		"message":    template.Message,
+		"status":     template.Status,
+		"components": flattenAffectedComponents(template.Statuses),
+	}
+}
+
+// customizeDiffFromTemplate fills the arguments in keys that are not set in
+// the configuration from the template referenced by template_id. Templates
+// only apply when the resource is created or template_id changes, so later
+// edits to a template leave existing incidents and maintenances alone.
+// Arguments in required must be set unless a template provides them.
+func customizeDiffFromTemplate(templateType string, keys []string, required []string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.GetRawConfig().IsNull() {
+			return nil
+		}
+
+		var unset []string
+		for _, key := range keys {
+			if configUnset(d, key) {
+				unset = append(unset, key)
+			}
+		}
+
+		markUnsetComputed := func() error {
+			for _, key := range unset {
+				if err := d.SetNewComputed(key); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		if !d.NewValueKnown("template_id") {
+			return markUnsetComputed()
+		}
+
+		templateID := d.Get("template_id").(string)
+		applyTemplate := templateID != "" && (d.Id() == "" || d.HasChange("template_id"))
+
+		// The template can only be read once the page it belongs to is known
+		if applyTemplate && !d.NewValueKnown("page_id") {
+			return markUnsetComputed()
+		}
+
+		var defaults map[string]interface{}
+		if applyTemplate {
+			template, err := meta.(*Client).GetTemplate(d.Get("page_id").(string), templateID)
+			if err != nil {
+				return fmt.Errorf("error reading template %s: %w", templateID, err)
+			}
+			if template.Type != templateType {
+				return fmt.Errorf("template %s is a %s template, expected %s", templateID, template.Type, templateType)
+			}
+			defaults = templateDefaults(template)
+		}
+
+		for _, key := range unset {
+			switch {
+			case applyTemplate:
+				if err := d.SetNew(key, defaults[key]); err != nil {
+					return err
+				}
+			case templateID == "" && key == "components":
+				// Without a template, removing every block clears the components
+				if err := d.SetNew(key, []interface{}{}); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, key := range required {
+			if v, ok := d.GetOk(key); !ok || v == "" {
+				if templateID == "" {
+					return fmt.Errorf("%s is required unless template_id is set", key)
+				}
+				return fmt.Errorf("%s is required as template %s does not set it", key, templateID)
+			}
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,164 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// testUnknownValue marks a value in a raw config as unknown until apply
+const testUnknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+// testRawConfig returns the config Terraform sends for the given values,
+// with every other argument left null
+func testRawConfig(r *schema.Resource, values map[string]cty.Value) cty.Value {
+	attrs := make(map[string]cty.Value)
+	for name, ty := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		attrs[name] = cty.NullVal(ty)
+		if v, ok := values[name]; ok {
+			attrs[name] = v
+		}
+	}
+	return cty.ObjectVal(attrs)
+}
+
+func TestCustomizeDiffFromTemplate_unknownPage(t *testing.T) {
+	r := resourceIncident()
+	raw := map[string]interface{}{
+		"page_id":     testUnknownValue,
+		"template_id": "template-id",
+	}
+	state := &terraform.InstanceState{
+		RawConfig: testRawConfig(r, map[string]cty.Value{
+			"page_id":     cty.UnknownVal(cty.String),
+			"template_id": cty.StringVal("template-id"),
+		}),
+	}
+
+	// A nil meta makes sure the template is not read
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, key := range []string{"name", "message", "status"} {
+		if attr, ok := diff.Attributes[key]; !ok || !attr.NewComputed {
+			t.Errorf("got %s diff %#v, want it computed", key, attr)
+		}
+	}
+}
+
+func TestAccResourceIncidentTemplate_incidentDefaults(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIncidentTemplateConfig("MONITORING"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_incident_template.test", "status", "INVESTIGATING"),
+					resource.TestCheckResourceAttr("instatus_incident.test", "name", "API degraded"),
+					resource.TestCheckResourceAttr("instatus_incident.test", "message", "We are investigating elevated error rates on the API."),
+					resource.TestCheckResourceAttr("instatus_incident.test", "status", "MONITORING"),
+					resource.TestCheckResourceAttr("instatus_incident.test", "components.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "instatus_incident_template.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccPageScopedImportID("instatus_incident_template.test"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccResourceMaintenanceTemplate_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMaintenanceTemplateConfig("Database patching"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_maintenance_template.test", "title", "Database patching"),
+					resource.TestCheckResourceAttr("instatus_maintenance_template.test", "components.#", "1"),
+				),
+			},
+			{
+				Config: testAccResourceMaintenanceTemplateConfig("Scheduled database patching"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_maintenance_template.test", "title", "Scheduled database patching"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceIncidentTemplateConfig(incidentStatus string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-incident-templates"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "API"
+}
+
+resource "instatus_incident_template" "test" {
+  page_id = instatus_page.test.id
+  name    = "API degradation"
+  title   = "API degraded"
+  message = "We are investigating elevated error rates on the API."
+  status  = "INVESTIGATING"
+
+  components {
+    component_id = instatus_component.test.id
+    status       = "DEGRADEDPERFORMANCE"
+  }
+}
+
+resource "instatus_incident" "test" {
+  page_id     = instatus_page.test.id
+  template_id = instatus_incident_template.test.id
+  status      = %q
+}
+`, incidentStatus)
+}
+
+func testAccResourceMaintenanceTemplateConfig(title string) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-maintenance-templates"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "Database"
+}
+
+resource "instatus_maintenance_template" "test" {
+  page_id = instatus_page.test.id
+  name    = "Database patching"
+  title   = %q
+  message = "The primary database will be patched."
+
+  components {
+    component_id = instatus_component.test.id
+    status       = "UNDERMAINTENANCE"
+  }
+}
+`, title)
+}
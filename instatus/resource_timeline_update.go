@@ -0,0 +1,213 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// timelineUpdateKind describes what a timeline update is posted on
+type timelineUpdateKind struct {
+	parent            string // "incident" or "maintenance"
+	statuses          []string
+	statusDescription string
+	create            func(c *Client, pageID, parentID string, update *TimelineUpdate) (*TimelineUpdate, error)
+	get               func(c *Client, pageID, parentID, updateID string) (*TimelineUpdate, error)
+	delete            func(c *Client, pageID, parentID, updateID string) error
+}
+
+func resourceIncidentTimelineUpdate() *schema.Resource {
+	return resourceTimelineUpdate(timelineUpdateKind{
+		parent:            "incident",
+		statuses:          incidentStatuses,
+		statusDescription: "INVESTIGATING, IDENTIFIED, MONITORING, RESOLVED",
+		create:            (*Client).CreateIncidentUpdate,
+		get:               (*Client).GetIncidentUpdate,
+		delete:            (*Client).DeleteIncidentUpdate,
+	})
+}
+
+func resourceMaintenanceTimelineUpdate() *schema.Resource {
+	return resourceTimelineUpdate(timelineUpdateKind{
+		parent:            "maintenance",
+		statuses:          maintenanceStatuses,
+		statusDescription: "NOTSTARTEDYET, INPROGRESS, COMPLETED",
+		create:            (*Client).CreateMaintenanceUpdate,
+		get:               (*Client).GetMaintenanceUpdate,
+		delete:            (*Client).DeleteMaintenanceUpdate,
+	})
+}
+
+// resourceTimelineUpdate builds the resource for incident or maintenance
+// updates, which only differ in their parent and its statuses
+func resourceTimelineUpdate(kind timelineUpdateKind) *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceTimelineUpdateCreate(kind),
+		ReadContext:   resourceTimelineUpdateRead(kind),
+		DeleteContext: resourceTimelineUpdateDelete(kind),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTimelineUpdateImport(kind),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"page_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the status page",
+			},
+			kind.parent + "_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: fmt.Sprintf("The ID of the %s to post the update on", kind.parent),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(kind.statuses, false),
+				Description:  fmt.Sprintf("The status of the %s after this update (%s)", kind.parent, kind.statusDescription),
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The message of the update",
+			},
+			"components": affectedComponentsSchema("The components affected by the update along with their new status", true),
+			"notify_subscribers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+				Description: "Whether to notify subscribers of the update",
+			},
+			"translations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Localized messages of the update, keyed by language code",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"started": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the update was posted",
+			},
+		},
+	}
+}
+
+// resourceTimelineUpdateImport accepts an ID of the form
+// <page_id>/<parent_id>/<update_id>
+func resourceTimelineUpdateImport(kind timelineUpdateKind) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		parts := strings.Split(d.Id(), "/")
+		if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("unexpected ID %q, expected <page_id>/<%s_id>/<%s_update_id>", d.Id(), kind.parent, kind.parent)
+		}
+
+		d.SetId(parts[2])
+		if err := d.Set("page_id", parts[0]); err != nil {
+			return nil, err
+		}
+		if err := d.Set(kind.parent+"_id", parts[1]); err != nil {
+			return nil, err
+		}
+		if err := d.Set("notify_subscribers", true); err != nil {
+			return nil, err
+		}
+
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func resourceTimelineUpdateCreate(kind timelineUpdateKind) schema.CreateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*Client)
+
+		components, statuses := expandAffectedComponents(d.Get("components").(*schema.Set).List())
+
+		update := &TimelineUpdate{
+			Message:    d.Get("message").(string),
+			Status:     d.Get("status").(string),
+			Notify:     d.Get("notify_subscribers").(bool),
+			Components: components,
+			Statuses:   statuses,
+		}
+
+		if translations := expandStringMap(d.Get("translations").(map[string]interface{})); len(translations) > 0 {
+			update.Translations = &UpdateTranslations{Message: translations}
+		}
+
+		created, err := kind.create(client, d.Get("page_id").(string), d.Get(kind.parent+"_id").(string), update)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error creating %s update: %w", kind.parent, err))
+		}
+
+		d.SetId(created.ID)
+
+		return resourceTimelineUpdateRead(kind)(ctx, d, meta)
+	}
+}
+
+func resourceTimelineUpdateRead(kind timelineUpdateKind) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*Client)
+		var diags diag.Diagnostics
+
+		update, err := kind.get(client, d.Get("page_id").(string), d.Get(kind.parent+"_id").(string), d.Id())
+		if err != nil {
+			if IsNotFound(err) && !d.IsNewResource() {
+				d.SetId("")
+				return diags
+			}
+			return diag.FromErr(fmt.Errorf("error reading %s update: %w", kind.parent, err))
+		}
+
+		if err := d.Set("message", update.Message); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("status", update.Status); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("components", flattenAffectedComponents(update.Statuses)); err != nil {
+			return diag.FromErr(err)
+		}
+		if err := d.Set("started", update.Started); err != nil {
+			return diag.FromErr(err)
+		}
+
+		// Clear translations removed outside of Terraform
+		var translations map[string]string
+		if update.Translations != nil {
+			translations = update.Translations.Message
+		}
+		if err := d.Set("translations", translations); err != nil {
+			return diag.FromErr(err)
+		}
+
+		return diags
+	}
+}
+
+func resourceTimelineUpdateDelete(kind timelineUpdateKind) schema.DeleteContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		client := meta.(*Client)
+		var diags diag.Diagnostics
+
+		err := kind.delete(client, d.Get("page_id").(string), d.Get(kind.parent+"_id").(string), d.Id())
+		if err != nil && !IsNotFound(err) {
+			return diag.FromErr(fmt.Errorf("error deleting %s update: %w", kind.parent, err))
+		}
+
+		d.SetId("")
+
+		return diags
+	}
+}
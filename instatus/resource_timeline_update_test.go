@@ -0,0 +1,232 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestResourceTimelineUpdateRead(t *testing.T) {
+	cases := map[string]struct {
+		resource *schema.Resource
+		parent   string
+		path     string
+	}{
+		"incident": {
+			resource: resourceIncidentTimelineUpdate(),
+			parent:   "incident",
+			path:     "/v1/page-id/incidents/parent-id/incident-updates/update-id",
+		},
+		"maintenance": {
+			resource: resourceMaintenanceTimelineUpdate(),
+			parent:   "maintenance",
+			path:     "/v1/page-id/maintenances/parent-id/maintenance-updates/update-id",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != "GET" || r.URL.Path != tc.path {
+					t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				fmt.Fprint(w, `{
+					"id": "update-id",
+					"message": "Fixed",
+					"status": "RESOLVED",
+					"components": [{"id": "component-id", "name": "API", "status": "OPERATIONAL"}]
+				}`)
+			})
+
+			// The state was written before the update's translations were
+			// removed and its components changed outside of Terraform
+			d := schema.TestResourceDataRaw(t, tc.resource.Schema, map[string]interface{}{
+				"page_id":         "page-id",
+				tc.parent + "_id": "parent-id",
+				"message":         "Fixed",
+				"status":          "RESOLVED",
+				"translations":    map[string]interface{}{"fr": "Corrigé"},
+			})
+			d.SetId("update-id")
+
+			if diags := tc.resource.ReadContext(context.Background(), d, client); diags.HasError() {
+				t.Fatalf("unexpected error: %#v", diags)
+			}
+
+			if got := d.Get("translations").(map[string]interface{}); len(got) != 0 {
+				t.Fatalf("got translations %v, want none", got)
+			}
+			components := d.Get("components").(*schema.Set).List()
+			if len(components) != 1 {
+				t.Fatalf("got components %v, want one", components)
+			}
+			component := components[0].(map[string]interface{})
+			if component["component_id"] != "component-id" || component["status"] != "OPERATIONAL" {
+				t.Fatalf("got component %v, want component-id OPERATIONAL", component)
+			}
+		})
+	}
+}
+
+func TestAccResourceIncidentTimelineUpdate_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceIncidentTimelineUpdateConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_incident_update.test", "status", "MONITORING"),
+					resource.TestCheckResourceAttr("instatus_incident_update.test", "components.#", "1"),
+					resource.TestCheckResourceAttr("instatus_incident_update.test", "translations.fr", "Un correctif a été déployé."),
+					resource.TestCheckResourceAttrSet("instatus_incident_update.test", "started"),
+				),
+			},
+			{
+				ResourceName:      "instatus_incident_update.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTimelineUpdateImportID("instatus_incident_update.test", "incident_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceIncidentTimelineUpdateConfig() string {
+	return `
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-incident-updates"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "API"
+
+  lifecycle {
+    ignore_changes = [status]
+  }
+}
+
+resource "instatus_incident" "test" {
+  page_id = instatus_page.test.id
+  name    = "API unavailable"
+  message = "We are investigating errors on the public API."
+  status  = "INVESTIGATING"
+
+  components {
+    component_id = instatus_component.test.id
+    status       = "MAJOROUTAGE"
+  }
+
+  lifecycle {
+    ignore_changes = [status, components]
+  }
+}
+
+resource "instatus_incident_update" "test" {
+  page_id     = instatus_page.test.id
+  incident_id = instatus_incident.test.id
+  status      = "MONITORING"
+  message     = "A fix has been deployed."
+
+  components {
+    component_id = instatus_component.test.id
+    status       = "DEGRADEDPERFORMANCE"
+  }
+
+  translations = {
+    fr = "Un correctif a été déployé."
+  }
+}
+`
+}
+
+func TestAccResourceMaintenanceTimelineUpdate_basic(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Minute)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceMaintenanceTimelineUpdateConfig(start),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_maintenance_update.test", "status", "NOTSTARTEDYET"),
+					resource.TestCheckResourceAttr("instatus_maintenance_update.test", "components.#", "1"),
+					resource.TestCheckResourceAttr("instatus_maintenance_update.test", "translations.%", "0"),
+					resource.TestCheckResourceAttrSet("instatus_maintenance_update.test", "started"),
+				),
+			},
+			{
+				ResourceName:      "instatus_maintenance_update.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccTimelineUpdateImportID("instatus_maintenance_update.test", "maintenance_id"),
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccResourceMaintenanceTimelineUpdateConfig(start time.Time) string {
+	return fmt.Sprintf(`
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-page-maintenance-updates"
+  force_destroy  = true
+}
+
+resource "instatus_component" "test" {
+  page_id = instatus_page.test.id
+  name    = "Database"
+}
+
+resource "instatus_maintenance" "test" {
+  page_id = instatus_page.test.id
+  name    = "Database patching"
+  message = "The database will be patched."
+  start   = %q
+  end     = %q
+
+  components {
+    component_id = instatus_component.test.id
+    status       = "UNDERMAINTENANCE"
+  }
+}
+
+resource "instatus_maintenance_update" "test" {
+  page_id        = instatus_page.test.id
+  maintenance_id = instatus_maintenance.test.id
+  status         = "NOTSTARTEDYET"
+  message        = "The patching now also covers the replicas."
+
+  components {
+    component_id = instatus_component.test.id
+    status       = "UNDERMAINTENANCE"
+  }
+}
+`, start.Format(time.RFC3339), start.Add(2*time.Hour).Format(time.RFC3339))
+}
+
+// testAccTimelineUpdateImportID builds the <page_id>/<parent_id>/<update_id>
+// import ID of an incident or maintenance update
+func testAccTimelineUpdateImportID(resourceName, parentAttribute string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["page_id"], rs.Primary.Attributes[parentAttribute], rs.Primary.ID), nil
+	}
+}
@@ -0,0 +1,117 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceWorkspace() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkspaceCreate,
+		ReadContext:   resourceWorkspaceRead,
+		UpdateContext: resourceWorkspaceUpdate,
+		DeleteContext: resourceWorkspaceDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the workspace",
+			},
+			"slug": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique slug of the workspace",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Billing email address for the workspace",
+			},
+		},
+	}
+}
+
+func resourceWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	workspace := &Workspace{
+		Name:  d.Get("name").(string),
+		Slug:  d.Get("slug").(string),
+		Email: d.Get("email").(string),
+	}
+
+	created, err := client.CreateWorkspace(workspace)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating workspace: %w", err))
+	}
+
+	d.SetId(created.ID)
+
+	return resourceWorkspaceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	workspace, err := client.GetWorkspace(d.Id())
+	if err != nil {
+		if IsNotFound(err) && !d.IsNewResource() {
+			d.SetId("")
+			return diags
+		}
+		return diag.FromErr(fmt.Errorf("error reading workspace: %w", err))
+	}
+
+	if err := d.Set("name", workspace.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("slug", workspace.Slug); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", workspace.Email); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+
+	workspace := &Workspace{
+		Name:  d.Get("name").(string),
+		Slug:  d.Get("slug").(string),
+		Email: d.Get("email").(string),
+	}
+
+	_, err := client.UpdateWorkspace(d.Id(), workspace)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error updating workspace: %w", err))
+	}
+
+	return resourceWorkspaceRead(ctx, d, meta)
+}
+
+func resourceWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*Client)
+	var diags diag.Diagnostics
+
+	err := client.DeleteWorkspace(d.Id())
+	if err != nil && !IsNotFound(err) {
+		return diag.FromErr(fmt.Errorf("error deleting workspace: %w", err))
+	}
+
+	d.SetId("")
+
+	return diags
+}
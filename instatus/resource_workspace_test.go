@@ -0,0 +1,42 @@
+package instatus
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccResourceWorkspace_withPage(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceWorkspaceConfig_withPage(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("instatus_workspace.test", "name", "Test Workspace"),
+					resource.TestCheckResourceAttr("instatus_workspace.test", "slug", "test-workspace"),
+					resource.TestCheckResourceAttrPair("instatus_page.test", "workspace_id", "instatus_workspace.test", "id"),
+					resource.TestCheckResourceAttr("instatus_page.test", "delete_workspace", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceWorkspaceConfig_withPage() string {
+	return `
+resource "instatus_workspace" "test" {
+  name  = "Test Workspace"
+  slug  = "test-workspace"
+  email = "test@example.com"
+}
+
+resource "instatus_page" "test" {
+  email          = "test@example.com"
+  name           = "Test Page"
+  workspace_slug = "test-workspace-page"
+  workspace_id   = instatus_workspace.test.id
+}
+`
+}
@@ -0,0 +1,77 @@
+package instatus
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// waitUntilReadable polls read with backoff until it stops failing with a
+// not found error. Instatus provisions new objects asynchronously, so they
+// are not always readable right after the create call returns.
+func waitUntilReadable(ctx context.Context, timeout time.Duration, read func() error) error {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{"PROVISIONING"},
+		Target:  []string{"READY"},
+		Refresh: func() (interface{}, string, error) {
+			if err := read(); err != nil {
+				if IsNotFound(err) {
+					return false, "PROVISIONING", nil
+				}
+				return nil, "", err
+			}
+			return true, "READY", nil
+		},
+		Timeout:    timeout,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+	return err
+}
+
+// parsePageScopedID splits an import ID of the form <page_id>/<id>
+func parsePageScopedID(id string) (string, string, error) {
+	pageID, objectID, ok := strings.Cut(id, "/")
+	if !ok || pageID == "" || objectID == "" {
+		return "", "", fmt.Errorf("unexpected ID %q, expected <page_id>/<id>", id)
+	}
+	return pageID, objectID, nil
+}
+
+// suppressEquivalentRFC3339 ignores differences in how the same instant is
+// written, as Instatus returns timestamps in UTC with milliseconds
+func suppressEquivalentRFC3339(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+// setIfReturned sets key only when the API response includes a value.
+// Instatus leaves secrets such as phone numbers, webhook URLs and monitor
+// headers out of its responses, so an empty value means "not returned"
+// rather than "removed". The configured value is kept in that case, which
+// means these attributes cannot detect a removal made outside Terraform.
+func setIfReturned(d *schema.ResourceData, key string, value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+	case map[string]string:
+		if len(v) == 0 {
+			return nil
+		}
+	}
+	return d.Set(key, value)
+}